@@ -0,0 +1,268 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package dictionary looks words up in a Hunspell dictionary. It understands
+// the subset of the format the embedded en_US dictionary uses: single
+// character flags and prefix and suffix rules. Compounds aren't supported, so
+// the words the dictionary only allows in compounds, e.g. 1th, are unknown.
+package dictionary
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+//go:embed en_US.aff
+var defaultAff []byte
+
+//go:embed en_US.dic
+var defaultDic []byte
+
+//go:embed technical.txt
+var technicalWords []byte
+
+// affix is a prefix or suffix rule. A suffix rule turns a stem ending in strip
+// and matching cond into a word ending in add, and a prefix rule does the same
+// at the start of the stem.
+type affix struct {
+	flag  rune
+	strip string
+	add   string
+	cond  *regexp.Regexp
+	// cross says the rule combines with rules of the other kind.
+	cross bool
+}
+
+// Dictionary is a set of words, stored as stems and the affix rules that apply
+// to them.
+type Dictionary struct {
+	// stems maps each stem to its flags.
+	stems    map[string]string
+	prefixes []affix
+	suffixes []affix
+	// onlyInCompound flags the stems that aren't words on their own.
+	onlyInCompound rune
+}
+
+// Default returns the embedded en_US dictionary, plus the technical words
+// common in Terraform modules. It is parsed on first use.
+var Default = sync.OnceValue(func() *Dictionary {
+	d, err := Parse(defaultAff, defaultDic)
+	if err != nil {
+		panic(fmt.Sprintf("embedded dictionary: %s", err))
+	}
+	for _, line := range strings.Split(string(technicalWords), "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
+			d.stems[line] += ""
+		}
+	}
+	return d
+})
+
+// Parse returns the dictionary of the aff and dic files.
+func Parse(aff []byte, dic []byte) (*Dictionary, error) {
+	d := &Dictionary{stems: map[string]string{}}
+	if err := d.parseAff(aff); err != nil {
+		return nil, fmt.Errorf("aff: %w", err)
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(dic))
+	first := true
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		// The first line holds the approximate number of entries.
+		if first {
+			first = false
+			if _, err := strconv.Atoi(line); err == nil {
+				continue
+			}
+		}
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		word, flags, _ := strings.Cut(line, "/")
+		// Morphological fields follow a tab.
+		flags, _, _ = strings.Cut(flags, "\t")
+		d.stems[word] += flags
+	}
+	return d, scanner.Err()
+}
+
+// parseAff reads the affix rules of an aff file.
+func (d *Dictionary) parseAff(aff []byte) error {
+	scanner := bufio.NewScanner(bytes.NewReader(aff))
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case "ONLYINCOMPOUND":
+			if len(fields) > 1 {
+				d.onlyInCompound, _ = utf8.DecodeRuneInString(fields[1])
+			}
+		case "PFX", "SFX":
+			// The header of a rule set is "SFX flag cross count", and each
+			// rule "SFX flag strip add cond".
+			if len(fields) == 4 {
+				continue
+			}
+			if len(fields) < 5 {
+				return fmt.Errorf("line %d: malformed %s rule", line, fields[0])
+			}
+			rule, err := d.parseRule(fields, aff)
+			if err != nil {
+				return fmt.Errorf("line %d: %w", line, err)
+			}
+			if fields[0] == "PFX" {
+				d.prefixes = append(d.prefixes, rule)
+			} else {
+				d.suffixes = append(d.suffixes, rule)
+			}
+		}
+	}
+	return scanner.Err()
+}
+
+// parseRule parses the fields of a prefix or suffix rule.
+func (d *Dictionary) parseRule(fields []string, aff []byte) (affix, error) {
+	flag, _ := utf8.DecodeRuneInString(fields[1])
+	rule := affix{flag: flag, strip: fields[2], add: fields[3], cross: crossProduct(aff, fields[0], fields[1])}
+	if rule.strip == "0" {
+		rule.strip = ""
+	}
+	// Continuation flags after the affix aren't used by the en_US dictionary.
+	rule.add, _, _ = strings.Cut(rule.add, "/")
+	if rule.add == "0" {
+		rule.add = ""
+	}
+
+	pattern := "^(?:" + fields[4] + ")"
+	if fields[0] == "SFX" {
+		pattern = "(?:" + fields[4] + ")$"
+	}
+	if fields[4] == "." {
+		pattern = ""
+	}
+	cond, err := regexp.Compile(pattern)
+	if err != nil {
+		return affix{}, err
+	}
+	rule.cond = cond
+	return rule, nil
+}
+
+// crossProduct reports whether the rule set of the flag says its rules combine
+// with those of the other kind.
+func crossProduct(aff []byte, kind string, flag string) bool {
+	scanner := bufio.NewScanner(bytes.NewReader(aff))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 4 && fields[0] == kind && fields[1] == flag {
+			return fields[2] == "Y"
+		}
+	}
+	return false
+}
+
+// Contains reports whether word, as written, is in the dictionary.
+func (d *Dictionary) Contains(word string) bool {
+	if d.isStem(word, 0) {
+		return true
+	}
+
+	for _, suffix := range d.suffixes {
+		if stem, ok := suffix.stemOfSuffixed(word); ok && d.isStem(stem, suffix.flag) {
+			return true
+		}
+	}
+
+	for _, prefix := range d.prefixes {
+		stem, ok := prefix.stemOfPrefixed(word)
+		if !ok {
+			continue
+		}
+		if d.isStem(stem, prefix.flag) {
+			return true
+		}
+		if !prefix.cross {
+			continue
+		}
+		for _, suffix := range d.suffixes {
+			if !suffix.cross {
+				continue
+			}
+			if root, ok := suffix.stemOfSuffixed(stem); ok && d.isStem(root, prefix.flag) && d.isStem(root, suffix.flag) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Check reports whether word is spelled correctly, allowing for its case the
+// way Hunspell does: a capitalized word may be a lowercase one starting a
+// sentence, and a word in capitals may be any.
+func (d *Dictionary) Check(word string) bool {
+	word = strings.ReplaceAll(word, "’", "'")
+	if d.Contains(word) {
+		return true
+	}
+
+	lower := strings.ToLower(word)
+	first, size := utf8.DecodeRuneInString(word)
+	switch {
+	case word == strings.ToUpper(word):
+		return d.Contains(lower) || d.Contains(capitalize(lower))
+	case unicode.IsUpper(first) && word[size:] == lower[size:]:
+		return d.Contains(lower)
+	}
+	return false
+}
+
+// isStem reports whether stem is a stem of the dictionary that takes flag, or
+// any stem that is a word on its own if flag is 0.
+func (d *Dictionary) isStem(stem string, flag rune) bool {
+	flags, ok := d.stems[stem]
+	if !ok {
+		return false
+	}
+	if flag == 0 {
+		return d.onlyInCompound == 0 || !strings.ContainsRune(flags, d.onlyInCompound)
+	}
+	return strings.ContainsRune(flags, flag)
+}
+
+// stemOfSuffixed returns the stem the suffix rule turns into word.
+func (a affix) stemOfSuffixed(word string) (string, bool) {
+	if !strings.HasSuffix(word, a.add) || len(word) == len(a.add) {
+		return "", false
+	}
+	stem := word[:len(word)-len(a.add)] + a.strip
+	return stem, a.cond.MatchString(stem)
+}
+
+// stemOfPrefixed returns the stem the prefix rule turns into word.
+func (a affix) stemOfPrefixed(word string) (string, bool) {
+	if !strings.HasPrefix(word, a.add) || len(word) == len(a.add) {
+		return "", false
+	}
+	stem := a.strip + word[len(a.add):]
+	return stem, a.cond.MatchString(stem)
+}
+
+// capitalize returns word with its first letter in upper case.
+func capitalize(word string) string {
+	first, size := utf8.DecodeRuneInString(word)
+	return string(unicode.ToUpper(first)) + word[size:]
+}
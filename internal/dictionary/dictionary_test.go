@@ -0,0 +1,59 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package dictionary
+
+import "testing"
+
+func TestCheck(t *testing.T) {
+	cases := []struct {
+		word string
+		want bool
+	}{
+		{"region", true},
+		{"Region", true},
+		{"REGION", true},
+		{"rEGION", false},
+		{"deployed", true},
+		{"running", true},
+		{"reconfigured", true},
+		{"module's", true},
+		{"module’s", true},
+		{"subnets", true},
+		{"Terraform", true},
+		{"recieve", false},
+		{"teh", false},
+		{"1th", false},
+	}
+
+	d := Default()
+	for _, tc := range cases {
+		t.Run(tc.word, func(t *testing.T) {
+			if got := d.Check(tc.word); got != tc.want {
+				t.Errorf("Check(%q) = %v, want %v", tc.word, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	aff := []byte("SFX S Y 1\nSFX S 0 s .\nPFX U Y 1\nPFX U 0 un .\n")
+	dic := []byte("2\nlock/SU\nbolt\n")
+
+	d, err := Parse(aff, dic)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for word, want := range map[string]bool{
+		"lock": true, "locks": true, "unlock": true, "unlocks": true,
+		"bolt": true, "bolts": false, "unbolt": false,
+	} {
+		if got := d.Contains(word); got != want {
+			t.Errorf("Contains(%q) = %v, want %v", word, got, want)
+		}
+	}
+
+	if _, err := Parse([]byte("SFX S Y 1\nSFX S 0\n"), dic); err == nil {
+		t.Error("expected an error for a malformed rule")
+	}
+}
@@ -0,0 +1,264 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rulehelper
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+// CommentGroup is a run of comments with no code or blank line between them.
+// A comment trailing code on its line is a group of its own.
+type CommentGroup struct {
+	Tokens []hclsyntax.Token
+}
+
+// Range returns the range of the group, from the start of its first comment to
+// the end of its last one. A line comment ends at the start of the next line,
+// as its token includes the newline.
+func (g *CommentGroup) Range() hcl.Range {
+	return hcl.RangeBetween(g.Tokens[0].Range, g.Tokens[len(g.Tokens)-1].Range)
+}
+
+// Text returns the text of the group with the comment markers removed, one
+// line per comment line.
+func (g *CommentGroup) Text() string {
+	var lines []string
+	for _, token := range g.Tokens {
+		text := strings.TrimRight(string(token.Bytes), "\r\n")
+		switch {
+		case strings.HasPrefix(text, "/*"):
+			text = strings.TrimSuffix(strings.TrimPrefix(text, "/*"), "*/")
+			for _, line := range strings.Split(text, "\n") {
+				lines = append(lines, strings.TrimSpace(line))
+			}
+			continue
+		case strings.HasPrefix(text, "//"):
+			text = strings.TrimPrefix(text, "//")
+		default:
+			text = strings.TrimPrefix(text, "#")
+		}
+		lines = append(lines, strings.TrimPrefix(text, " "))
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// lastLine returns the last line holding a comment of the group.
+func (g *CommentGroup) lastLine() int {
+	return commentLastLine(g.Tokens[len(g.Tokens)-1])
+}
+
+// commentLastLine returns the last line a comment token is written on.
+func commentLastLine(token hclsyntax.Token) int {
+	if strings.HasSuffix(string(token.Bytes), "\n") {
+		return token.Range.End.Line - 1
+	}
+	return token.Range.End.Line
+}
+
+// nodeKey identifies a block or attribute by its position, so that a node
+// parsed from the same content by another call of GetFiles finds its comments.
+type nodeKey struct {
+	start int
+	end   int
+}
+
+// keyOf returns the key of node.
+func keyOf(node hclsyntax.Node) nodeKey {
+	rng := node.Range()
+	return nodeKey{start: rng.Start.Byte, end: rng.End.Byte}
+}
+
+// CommentMap binds the comments of a file to the blocks and attributes they
+// describe. Own-line comments lead the next block or attribute of their body,
+// and a comment after code on the same line trails the block or attribute
+// that ends there, or that starts there if none does, e.g. after the opening
+// brace of a block. Comments that precede nothing in their body, or sit inside
+// an expression, are left unattached.
+type CommentMap struct {
+	groups     []*CommentGroup
+	leading    map[nodeKey][]*CommentGroup
+	trailing   map[nodeKey]*CommentGroup
+	unattached []*CommentGroup
+}
+
+// Leading returns the comment groups that lead node, in source order.
+func (m *CommentMap) Leading(node hclsyntax.Node) []*CommentGroup {
+	return m.leading[keyOf(node)]
+}
+
+// Doc returns the doc comment of node: the last group leading it, if no blank
+// line separates the two. It returns nil if node has none.
+func (m *CommentMap) Doc(node hclsyntax.Node) *CommentGroup {
+	leading := m.Leading(node)
+	if len(leading) == 0 {
+		return nil
+	}
+	doc := leading[len(leading)-1]
+	if doc.lastLine()+1 != node.Range().Start.Line {
+		return nil
+	}
+	return doc
+}
+
+// Trailing returns the comment trailing node on the same line, or nil.
+func (m *CommentMap) Trailing(node hclsyntax.Node) *CommentGroup {
+	return m.trailing[keyOf(node)]
+}
+
+// Groups returns every comment group of the file, in source order.
+func (m *CommentMap) Groups() []*CommentGroup {
+	return m.groups
+}
+
+// Unattached returns the comment groups bound to no block or attribute.
+func (m *CommentMap) Unattached() []*CommentGroup {
+	return m.unattached
+}
+
+// Extent returns the range of node together with its leading and trailing
+// comments, i.e. what has to move when node moves.
+func (m *CommentMap) Extent(node hclsyntax.Node) hcl.Range {
+	rng := node.Range()
+	if leading := m.Leading(node); len(leading) > 0 {
+		rng = hcl.RangeBetween(leading[0].Range(), rng)
+	}
+	if trailing := m.Trailing(node); trailing != nil {
+		rng = hcl.RangeBetween(rng, trailing.Range())
+	}
+	return rng
+}
+
+// attachItem is a block or attribute along with the body it belongs to.
+type attachItem struct {
+	node   hclsyntax.Node
+	parent *hclsyntax.Body
+}
+
+// NewCommentMap builds the comment map of a native file from its tokens and
+// body.
+func NewCommentMap(tokens hclsyntax.Tokens, body *hclsyntax.Body) *CommentMap {
+	m := &CommentMap{
+		leading:  map[nodeKey][]*CommentGroup{},
+		trailing: map[nodeKey]*CommentGroup{},
+	}
+
+	var items []attachItem
+	var collect func(*hclsyntax.Body)
+	collect = func(b *hclsyntax.Body) {
+		for _, attr := range b.Attributes {
+			items = append(items, attachItem{node: attr, parent: b})
+		}
+		for _, block := range b.Blocks {
+			items = append(items, attachItem{node: block, parent: b})
+			collect(block.Body)
+		}
+	}
+	collect(body)
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].node.Range().Start.Byte < items[j].node.Range().Start.Byte
+	})
+
+	var group *CommentGroup
+	flush := func() {
+		if group != nil {
+			m.groups = append(m.groups, group)
+			m.attachLeading(group, items, body)
+			group = nil
+		}
+	}
+
+	for i, token := range tokens {
+		if token.Type != hclsyntax.TokenComment {
+			// A line comment swallows its newline, so any newline token between
+			// two comments is a blank line or the end of a block comment's line.
+			if token.Type != hclsyntax.TokenNewline || group == nil ||
+				token.Range.Start.Line != group.lastLine() {
+				flush()
+			}
+			continue
+		}
+
+		if i > 0 && tokens[i-1].Type != hclsyntax.TokenNewline && tokens[i-1].Type != hclsyntax.TokenComment {
+			flush()
+			trailing := &CommentGroup{Tokens: []hclsyntax.Token{token}}
+			m.groups = append(m.groups, trailing)
+			m.attachTrailing(trailing, items)
+			continue
+		}
+
+		if group != nil && token.Range.Start.Line != group.lastLine()+1 {
+			flush()
+		}
+		if group == nil {
+			group = &CommentGroup{}
+		}
+		group.Tokens = append(group.Tokens, token)
+	}
+	flush()
+
+	return m
+}
+
+// attachLeading binds an own-line group to the next item of the body it sits
+// in.
+func (m *CommentMap) attachLeading(group *CommentGroup, items []attachItem, body *hclsyntax.Body) {
+	pos := group.Range().Start.Byte
+
+	// Find the innermost body holding the group. A group inside an attribute
+	// is part of its expression.
+	parent := body
+	for _, item := range items {
+		rng := item.node.Range()
+		if pos < rng.Start.Byte || pos >= rng.End.Byte {
+			continue
+		}
+		block, ok := item.node.(*hclsyntax.Block)
+		if !ok || pos < block.OpenBraceRange.End.Byte {
+			m.unattached = append(m.unattached, group)
+			return
+		}
+		parent = block.Body
+	}
+
+	for _, item := range items {
+		if item.parent == parent && item.node.Range().Start.Byte >= pos {
+			key := keyOf(item.node)
+			m.leading[key] = append(m.leading[key], group)
+			return
+		}
+	}
+	m.unattached = append(m.unattached, group)
+}
+
+// attachTrailing binds a comment after code to the item that ends last on its
+// line before it, or else to the innermost item starting on that line.
+func (m *CommentMap) attachTrailing(group *CommentGroup, items []attachItem) {
+	comment := group.Tokens[0].Range.Start
+
+	var ending, starting hclsyntax.Node
+	for _, item := range items {
+		rng := item.node.Range()
+		if rng.End.Line == comment.Line && rng.End.Byte <= comment.Byte {
+			if ending == nil || rng.End.Byte > ending.Range().End.Byte {
+				ending = item.node
+			}
+		}
+		if rng.Start.Line == comment.Line && rng.Start.Byte < comment.Byte && rng.End.Byte > comment.Byte {
+			starting = item.node
+		}
+	}
+
+	switch {
+	case ending != nil:
+		m.trailing[keyOf(ending)] = group
+	case starting != nil:
+		m.trailing[keyOf(starting)] = group
+	default:
+		m.unattached = append(m.unattached, group)
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rulehelper
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+func TestCommentMap(t *testing.T) {
+	resetSources()
+	t.Cleanup(resetSources)
+
+	src := `# Copyright header.
+
+# The web tier.
+# Serves the site.
+resource "aws_instance" "web" { # primary
+  /* The image. */
+  ami = "ami-123" # pinned
+
+  tags = {
+    # Inside an expression.
+    Name = "web"
+  }
+
+  lifecycle { create_before_destroy = true } # replace first
+  # Dangling before the brace.
+}
+
+// Separated from the variable.
+
+variable "region" {}
+`
+
+	file := parseTestFile(t, "main.tf", src)
+	m := Source("main.tf", file).CommentMap()
+	body := file.Body.(*hclsyntax.Body)
+	web := body.Blocks[0]
+	region := body.Blocks[1]
+	ami := web.Body.Attributes["ami"]
+	tags := web.Body.Attributes["tags"]
+	lifecycle := web.Body.Blocks[0]
+
+	texts := func(groups []*CommentGroup) string {
+		var out []string
+		for _, g := range groups {
+			out = append(out, g.Text())
+		}
+		return strings.Join(out, "|")
+	}
+
+	if got := texts(m.Leading(web)); got != "Copyright header.|The web tier.\nServes the site." {
+		t.Errorf("Leading(web) = %q", got)
+	}
+	if doc := m.Doc(web); doc == nil || doc.Text() != "The web tier.\nServes the site." {
+		t.Errorf("Doc(web) = %v", doc)
+	}
+	if got := m.Trailing(web); got == nil || got.Text() != "primary" {
+		t.Errorf("Trailing(web) = %v", got)
+	}
+
+	if doc := m.Doc(ami); doc == nil || doc.Text() != "The image." {
+		t.Errorf("Doc(ami) = %v", doc)
+	}
+	if got := m.Trailing(ami); got == nil || got.Text() != "pinned" {
+		t.Errorf("Trailing(ami) = %v", got)
+	}
+
+	if got := m.Leading(tags); len(got) != 0 {
+		t.Errorf("Leading(tags) = %q", texts(got))
+	}
+	if got := m.Trailing(lifecycle); got == nil || got.Text() != "replace first" {
+		t.Errorf("Trailing(lifecycle) = %v", got)
+	}
+
+	if got := texts(m.Leading(region)); got != "Separated from the variable." {
+		t.Errorf("Leading(region) = %q", got)
+	}
+	if doc := m.Doc(region); doc != nil {
+		t.Errorf("Doc(region) = %q, want none across a blank line", doc.Text())
+	}
+
+	if got := texts(m.Unattached()); got != "Inside an expression.|Dangling before the brace." {
+		t.Errorf("Unattached() = %q", got)
+	}
+
+	if got := len(m.Groups()); got != 9 {
+		t.Errorf("len(Groups()) = %d, want 9", got)
+	}
+	if first := m.Groups()[0]; first.Text() != "Copyright header." {
+		t.Errorf("Groups()[0] = %q", first.Text())
+	}
+
+	extent := m.Extent(ami)
+	if extent.Start.Line != 6 || extent.End.Line != 8 || extent.End.Column != 1 {
+		t.Errorf("Extent(ami) = %s, want 6,3 through the end of line 7", extent)
+	}
+}
+
+func TestCommentMapJSON(t *testing.T) {
+	resetSources()
+	t.Cleanup(resetSources)
+
+	file := parseTestFile(t, "main.tf.json", `{"variable": {"region": {}}}`)
+	m := Source("main.tf.json", file).CommentMap()
+	if len(m.Unattached()) != 0 {
+		t.Errorf("JSON file has comments: %v", m.Unattached())
+	}
+}
@@ -0,0 +1,366 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rulehelper
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/terraform"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// DefaultBaselineFile is the baseline read when the plugin config doesn't
+// name one.
+const DefaultBaselineFile = ".eos-baseline.json"
+
+// UpdateBaselineEnv names the environment variable that switches a run to
+// writing the baseline: every issue found is recorded instead of reported.
+const UpdateBaselineEnv = "EOS_UPDATE_BASELINE"
+
+// baselineVersion is the format version written to the baseline file.
+const baselineVersion = 1
+
+// baselineEntry is an issue accepted into the baseline. The fingerprint hashes
+// the issue message and the flagged source text rather than its position, so
+// an entry still matches after the lines around it move.
+type baselineEntry struct {
+	Rule        string `json:"rule"`
+	File        string `json:"file"`
+	Fingerprint string `json:"fingerprint"`
+
+	// rng is where the entry is in the baseline file.
+	rng     hcl.Range
+	matched bool
+}
+
+// baselineFile is the JSON layout of the baseline file.
+type baselineFile struct {
+	Version int              `json:"version"`
+	Entries []*baselineEntry `json:"entries"`
+}
+
+// baseline is the set of accepted issues of a run. When updating, it collects
+// the issues of every rule instead, and is written out once the rules have
+// checked the module.
+type baseline struct {
+	// path is the baseline file as configured, and file the path it resolves
+	// to from dir, the directory file names are relative to.
+	path string
+	file string
+	dir  string
+
+	update  bool
+	entries []*baselineEntry
+	// kept are the entries read from the file being updated. Those of a rule
+	// and file that weren't linted, i.e. aren't in linted, are written back.
+	kept   []*baselineEntry
+	linted map[string]map[string]bool
+}
+
+var (
+	// updatingMu guards updating and the baselines in it.
+	updatingMu sync.Mutex
+	// updating holds the baselines being written by this process. Every rule
+	// adds its issues to the same one, so they share it by file.
+	updating = map[string]*baseline{}
+)
+
+// BaselineRunner wraps a runner to drop the issues the baseline accepts, or to
+// record every issue when the baseline is being updated.
+type BaselineRunner struct {
+	tflint.Runner
+	rule     tflint.Rule
+	baseline *baseline
+}
+
+// NewBaselineRunner returns a runner applying the baseline named by the plugin
+// config of tr to the issues rule emits through runner, or nil if there is no
+// baseline. When updating, the baseline is written once tr is finished.
+func NewBaselineRunner(runner tflint.Runner, rule tflint.Rule, tr *terraform.Runner) (*BaselineRunner, error) {
+	dir, err := runner.GetOriginalwd()
+	if err != nil {
+		return nil, err
+	}
+	b, err := openBaseline(tr.Config.Baseline, dir)
+	if err != nil || b == nil {
+		return nil, err
+	}
+	if b.update {
+		tr.OnFinish("baseline:"+b.file, b.write)
+	}
+	return &BaselineRunner{Runner: runner, rule: rule, baseline: b}, nil
+}
+
+// EmitIssue emits the issue unless the baseline accepts it.
+func (r *BaselineRunner) EmitIssue(rule tflint.Rule, message string, issueRange hcl.Range) error {
+	if r.baselined(rule, message, issueRange) {
+		return nil
+	}
+	return r.Runner.EmitIssue(rule, message, issueRange)
+}
+
+// EmitIssueWithFix emits the issue and its fix unless the baseline accepts it.
+func (r *BaselineRunner) EmitIssueWithFix(rule tflint.Rule, message string, issueRange hcl.Range, fixFunc func(f tflint.Fixer) error) error {
+	if r.baselined(rule, message, issueRange) {
+		return nil
+	}
+	return r.Runner.EmitIssueWithFix(rule, message, issueRange, fixFunc)
+}
+
+// baselined reports whether the baseline accepts the issue, matching it by the
+// source text rng flags rather than by its lines.
+func (r *BaselineRunner) baselined(rule tflint.Rule, message string, rng hcl.Range) bool {
+	text := ""
+	if file, err := r.GetFile(rng.Filename); err == nil && file != nil &&
+		rng.Start.Byte <= rng.End.Byte && rng.End.Byte <= len(file.Bytes) {
+		text = string(file.Bytes[rng.Start.Byte:rng.End.Byte])
+	}
+	return r.baseline.match(rule.Name(), rng.Filename, fingerprint(rule.Name(), message, text))
+}
+
+// ReportStale emits an issue at each entry of the rule in the baseline file
+// that no issue matched. When updating, it records the files the rule linted
+// instead, so that their old entries are replaced.
+func (r *BaselineRunner) ReportStale() error {
+	files, err := r.GetFiles()
+	if err != nil {
+		return err
+	}
+	if r.baseline.update {
+		r.baseline.lint(r.rule.Name(), files)
+		return nil
+	}
+
+	for _, entry := range r.baseline.stale(r.rule.Name(), files) {
+		message := fmt.Sprintf("Remove stale baseline entry for '%s'.", entry.File)
+		if err := r.Runner.EmitIssue(r.rule, message, entry.rng); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// openBaseline returns the baseline at path, relative to dir unless absolute.
+// When the update variable is set it returns the baseline this process is
+// writing, otherwise the one on disk, or nil if there is none.
+func openBaseline(path string, dir string) (*baseline, error) {
+	if path == "" {
+		path = DefaultBaselineFile
+	}
+	file := path
+	if !filepath.IsAbs(file) {
+		file = filepath.Join(dir, file)
+	}
+
+	if value := os.Getenv(UpdateBaselineEnv); value != "" && value != "0" && !strings.EqualFold(value, "false") {
+		updatingMu.Lock()
+		defer updatingMu.Unlock()
+		if b, ok := updating[file]; ok {
+			return b, nil
+		}
+		kept, err := readBaseline(path, file)
+		if err != nil {
+			return nil, err
+		}
+		b := &baseline{path: path, file: file, dir: dir, update: true, kept: kept, linted: map[string]map[string]bool{}}
+		updating[file] = b
+		return b, nil
+	}
+
+	entries, err := readBaseline(path, file)
+	if err != nil || entries == nil {
+		return nil, err
+	}
+	return &baseline{path: path, file: file, dir: dir, entries: entries}, nil
+}
+
+// readBaseline reads the entries of the baseline file, or returns nil if there
+// is none. path is the name the issues at its entries are reported under.
+func readBaseline(path string, file string) ([]*baselineEntry, error) {
+	src, err := os.ReadFile(file)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var parsed baselineFile
+	if err := json.Unmarshal(src, &parsed); err != nil {
+		return nil, fmt.Errorf("baseline %s: %w", path, err)
+	}
+	if parsed.Version != baselineVersion {
+		return nil, fmt.Errorf("baseline %s: unsupported version %d", path, parsed.Version)
+	}
+
+	// Entries are written one per line, so the nth line holding a fingerprint
+	// is the nth entry. A baseline edited into another layout falls back to
+	// reporting its stale entries at the first line.
+	var first hcl.Range
+	var lines []hcl.Range
+	offset := 0
+	for i, line := range bytes.SplitAfter(src, []byte("\n")) {
+		if i == 0 {
+			first = lineRange(path, 1, offset, line)
+		}
+		if bytes.Contains(line, []byte(`"fingerprint"`)) {
+			lines = append(lines, lineRange(path, i+1, offset, line))
+		}
+		offset += len(line)
+	}
+	for i, entry := range parsed.Entries {
+		entry.rng = first
+		if len(lines) == len(parsed.Entries) {
+			entry.rng = lines[i]
+		}
+	}
+
+	// An empty baseline still exists, and reports nothing.
+	if parsed.Entries == nil {
+		parsed.Entries = []*baselineEntry{}
+	}
+	return parsed.Entries, nil
+}
+
+// lineRange returns the range of the text of a line, without its indentation,
+// trailing comma and newline. start is the offset of the line in the file.
+func lineRange(filename string, number int, start int, line []byte) hcl.Range {
+	text := bytes.TrimRight(line, " \t\r\n,")
+	indent := len(text) - len(bytes.TrimLeft(text, " \t"))
+	return hcl.Range{
+		Filename: filename,
+		Start:    hcl.Pos{Line: number, Column: indent + 1, Byte: start + indent},
+		End:      hcl.Pos{Line: number, Column: utf8.RuneCount(text) + 1, Byte: start + len(text)},
+	}
+}
+
+// fingerprint identifies an issue by its rule, message and flagged source
+// text. Whitespace in the text is collapsed so that reindenting doesn't
+// change it.
+func fingerprint(rule string, message string, text string) string {
+	sum := sha256.Sum256([]byte(rule + "\x00" + message + "\x00" + strings.Join(strings.Fields(text), " ")))
+	return hex.EncodeToString(sum[:8])
+}
+
+// match reports whether the baseline accepts the issue. Each entry accepts a
+// single issue, so a second identical issue is reported. When updating, the
+// issue is recorded and always accepted.
+func (b *baseline) match(rule string, file string, print string) bool {
+	if b.update {
+		updatingMu.Lock()
+		defer updatingMu.Unlock()
+		b.entries = append(b.entries, &baselineEntry{Rule: rule, File: file, Fingerprint: print})
+		return true
+	}
+
+	for _, entry := range b.entries {
+		if !entry.matched && entry.Rule == rule && entry.File == file && entry.Fingerprint == print {
+			entry.matched = true
+			return true
+		}
+	}
+	return false
+}
+
+// stale returns the entries of rule that no issue matched, limited to files
+// that were linted or no longer exist. Entries for files linted by another
+// run, e.g. another module, are left alone.
+func (b *baseline) stale(rule string, files map[string]*hcl.File) []*baselineEntry {
+	var entries []*baselineEntry
+	for _, entry := range b.entries {
+		if entry.Rule != rule || entry.matched {
+			continue
+		}
+		if _, linted := files[entry.File]; !linted && b.exists(entry.File) {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// exists reports whether the named file, relative to the baseline's directory
+// unless absolute, exists.
+func (b *baseline) exists(filename string) bool {
+	if !filepath.IsAbs(filename) {
+		filename = filepath.Join(b.dir, filename)
+	}
+	_, err := os.Stat(filename)
+	return err == nil
+}
+
+// lint records that rule linted files, so that the entries of the rule and
+// those files read from the baseline are replaced by the issues found.
+func (b *baseline) lint(rule string, files map[string]*hcl.File) {
+	updatingMu.Lock()
+	defer updatingMu.Unlock()
+
+	if b.linted[rule] == nil {
+		b.linted[rule] = map[string]bool{}
+	}
+	for filename := range files {
+		b.linted[rule][filename] = true
+	}
+}
+
+// write saves the baseline, sorted so that rewriting it with the same issues
+// leaves the file unchanged. Entries go one per line to keep diffs readable.
+// The entries read from the file are kept for the rules and files that
+// weren't linted, unless the file is gone.
+func (b *baseline) write() error {
+	updatingMu.Lock()
+	defer updatingMu.Unlock()
+
+	entries := append([]*baselineEntry(nil), b.entries...)
+	for _, entry := range b.kept {
+		if !b.linted[entry.Rule][entry.File] && b.exists(entry.File) {
+			entries = append(entries, entry)
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, c := entries[i], entries[j]
+		if a.File != c.File {
+			return a.File < c.File
+		}
+		if a.Rule != c.Rule {
+			return a.Rule < c.Rule
+		}
+		return a.Fingerprint < c.Fingerprint
+	})
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "{\n  \"version\": %d,\n  \"entries\": [", baselineVersion)
+	for i, entry := range entries {
+		line, err := json.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		buf.WriteString("\n    ")
+		buf.Write(line)
+	}
+	if len(entries) > 0 {
+		buf.WriteString("\n  ")
+	}
+	buf.WriteString("]\n}\n")
+
+	return os.WriteFile(b.file, buf.Bytes(), 0o644)
+}
@@ -0,0 +1,217 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rulehelper
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/terraform"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+// runBaseline runs the fake rule over content with the baseline at path, then
+// finishes the runner as TFLint's last rule does, and returns the messages of
+// the issues it reports, with the file of each.
+func runBaseline(t *testing.T, path string, content string) []string {
+	t.Helper()
+
+	testRunner, runner := checkBaseline(t, path, content)
+	if err := runner.Finish(); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	var got []string
+	for _, issue := range testRunner.Issues {
+		got = append(got, filepath.Base(issue.Range.Filename)+": "+issue.Message)
+	}
+	sort.Strings(got)
+	return got
+}
+
+// checkBaseline runs the fake rule over content with the baseline at path,
+// leaving the runner unfinished.
+func checkBaseline(t *testing.T, path string, content string) (*helper.Runner, *terraform.Runner) {
+	t.Helper()
+
+	rule := &ignoreTestRule{}
+	testRunner := helper.TestRunner(t, map[string]string{"test.tf": content})
+	runner := &terraform.Runner{Runner: testRunner, Config: &terraform.Config{Baseline: path}}
+
+	ignores, err := NewIgnoreRunner(runner, rule)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if err := WalkBlocks(ignores, AllLintableBlocks, rule, checkIgnoreUpper, checkIgnoreLong); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if err := ignores.ReportIgnores(); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	return testRunner, runner
+}
+
+func TestBaseline(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	content := `variable "Longer" {}
+variable "Second" {}
+`
+
+	// Writing the baseline reports nothing and records every issue.
+	t.Setenv(UpdateBaselineEnv, "1")
+	if got := runBaseline(t, path, content); len(got) != 0 {
+		t.Fatalf("Expected no issues while updating, got %v", got)
+	}
+	written, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Baseline not written: %s", err)
+	}
+	if count := strings.Count(string(written), `"fingerprint"`); count != 4 {
+		t.Fatalf("Expected 4 entries, got %d:\n%s", count, written)
+	}
+
+	t.Setenv(UpdateBaselineEnv, "")
+
+	t.Run("unchanged", func(t *testing.T) {
+		if got := runBaseline(t, path, content); len(got) != 0 {
+			t.Errorf("Expected no issues, got %v", got)
+		}
+	})
+
+	t.Run("shifted", func(t *testing.T) {
+		shifted := `# A comment pushing everything down.
+
+variable "Second" {}
+
+variable "Longer"    {}
+`
+		if got := runBaseline(t, path, shifted); len(got) != 0 {
+			t.Errorf("Expected no issues, got %v", got)
+		}
+	})
+
+	t.Run("new_and_stale", func(t *testing.T) {
+		changed := `variable "Longer" {}
+variable "Third3" {}
+`
+		want := []string{
+			"baseline.json: Remove stale baseline entry for 'test.tf'.",
+			"baseline.json: Remove stale baseline entry for 'test.tf'.",
+			"test.tf: long",
+			"test.tf: upper",
+		}
+		if diff := cmp.Diff(want, runBaseline(t, path, changed)); diff != "" {
+			t.Errorf("issues mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("stale_range", func(t *testing.T) {
+		testRunner, _ := checkBaseline(t, path, `variable "Longer" {}
+`)
+		src, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		stale := 0
+		for _, issue := range testRunner.Issues {
+			if issue.Range.Filename != path {
+				continue
+			}
+			stale++
+			text := string(src[issue.Range.Start.Byte:issue.Range.End.Byte])
+			if !strings.HasPrefix(text, `{"rule":"eos_fake"`) || !strings.HasSuffix(text, `}`) {
+				t.Errorf("Stale entry at %s flags %q, not its entry", issue.Range, text)
+			}
+		}
+		if stale != 2 {
+			t.Errorf("Expected 2 stale entries, got %d", stale)
+		}
+	})
+
+	t.Run("duplicate", func(t *testing.T) {
+		duplicated := content + `variable "Longer" {}
+`
+		want := []string{
+			"test.tf: long",
+			"test.tf: upper",
+		}
+		if diff := cmp.Diff(want, runBaseline(t, path, duplicated)); diff != "" {
+			t.Errorf("issues mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		content := `variable "Longer" {}
+`
+		want := []string{"test.tf: long", "test.tf: upper"}
+		if diff := cmp.Diff(want, runBaseline(t, filepath.Join(t.TempDir(), "none.json"), content)); diff != "" {
+			t.Errorf("issues mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
+
+func TestBaselineUpdate(t *testing.T) {
+	dir := t.TempDir()
+	t.Chdir(dir)
+	path := filepath.Join(dir, "baseline.json")
+	for _, name := range []string{"test.tf", "other.tf"} {
+		if err := os.WriteFile(name, nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Entries of the linted file are replaced, those of a file or rule that
+	// wasn't linted are kept, and those of a file that is gone are dropped.
+	old := `{
+  "version": 1,
+  "entries": [
+    {"rule":"eos_fake","file":"gone.tf","fingerprint":"0000000000000001"},
+    {"rule":"eos_fake","file":"other.tf","fingerprint":"0000000000000002"},
+    {"rule":"eos_fake","file":"test.tf","fingerprint":"0000000000000003"},
+    {"rule":"eos_other","file":"test.tf","fingerprint":"0000000000000004"}
+  ]
+}
+`
+	if err := os.WriteFile(path, []byte(old), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv(UpdateBaselineEnv, "1")
+	_, runner := checkBaseline(t, path, `variable "Longer" {}
+`)
+
+	// A rule failing after this one leaves the runner unfinished, and the
+	// baseline as it was.
+	if written, err := os.ReadFile(path); err != nil || string(written) != old {
+		t.Fatalf("Baseline written before the runner finished:\n%s", written)
+	}
+
+	if err := runner.Finish(); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	written, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, line := range strings.Split(string(written), "\n") {
+		if rule, rest, ok := strings.Cut(strings.TrimSpace(line), `"file":"`); ok {
+			file, _, _ := strings.Cut(rest, `"`)
+			got = append(got, strings.TrimSuffix(strings.TrimPrefix(rule, `{"rule":"`), `",`)+" "+file)
+		}
+	}
+	want := []string{"eos_fake other.tf", "eos_fake test.tf", "eos_fake test.tf", "eos_other test.tf"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("entries mismatch (-want +got):\n%s", diff)
+	}
+	if strings.Contains(string(written), "0000000000000003") {
+		t.Errorf("Entry of the linted file kept:\n%s", written)
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rulehelper
+
+import (
+	"hash/maphash"
+	"sync"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+// SourceFile holds what the rules derive from a file: its tokens, where its
+// comments are, what they are attached to and its top-level blocks. Each is
+// worked out on first use and shared by every rule of the run, so a file is
+// lexed and decoded once rather than once per rule. What it returns is shared,
+// so callers must not modify it.
+type SourceFile struct {
+	filename string
+	file     *hcl.File
+	sum      uint64
+
+	lexOnce  sync.Once
+	tokens   hclsyntax.Tokens
+	comments []int
+	lexDiags hcl.Diagnostics
+
+	blocksOnce sync.Once
+	blocks     hcl.Blocks
+
+	commentsOnce sync.Once
+	commentMap   *CommentMap
+}
+
+var (
+	// sourcesMu guards sources.
+	sourcesMu sync.Mutex
+	// sources holds the cached files by name. A file whose content changed,
+	// e.g. after a fix, replaces the entry of its previous content, so the
+	// cache never holds more than one entry per file.
+	sources = map[string]*SourceFile{}
+	// sourceSeed seeds the content hashes for the life of the process.
+	sourceSeed = maphash.MakeSeed()
+)
+
+// Source returns the cached SourceFile of filename. The entry is keyed by the
+// file's name and a hash of its content, so a file that changed since it was
+// cached is worked out afresh.
+func Source(filename string, file *hcl.File) *SourceFile {
+	sum := maphash.Bytes(sourceSeed, file.Bytes)
+
+	sourcesMu.Lock()
+	defer sourcesMu.Unlock()
+
+	if s, ok := sources[filename]; ok && s.sum == sum {
+		return s
+	}
+	s := &SourceFile{filename: filename, file: file, sum: sum}
+	sources[filename] = s
+	return s
+}
+
+// resetSources empties the cache.
+func resetSources() {
+	sourcesMu.Lock()
+	defer sourcesMu.Unlock()
+	sources = map[string]*SourceFile{}
+}
+
+// File returns the parsed file.
+func (s *SourceFile) File() *hcl.File {
+	return s.file
+}
+
+// lex lexes the file and indexes its comments.
+func (s *SourceFile) lex() {
+	s.lexOnce.Do(func() {
+		s.tokens, s.lexDiags = hclsyntax.LexConfig(s.file.Bytes, s.filename, hcl.InitialPos)
+		if s.lexDiags.HasErrors() {
+			return
+		}
+		for i, token := range s.tokens {
+			if token.Type == hclsyntax.TokenComment {
+				s.comments = append(s.comments, i)
+			}
+		}
+	})
+}
+
+// Tokens returns the tokens of the file, lexed as native syntax.
+func (s *SourceFile) Tokens() (hclsyntax.Tokens, hcl.Diagnostics) {
+	s.lex()
+	return s.tokens, s.lexDiags
+}
+
+// Comments returns the indexes of the comment tokens among Tokens, in source
+// order. It is empty if the file fails to lex.
+func (s *SourceFile) Comments() []int {
+	s.lex()
+	return s.comments
+}
+
+// Blocks returns the top-level blocks of the file as decoded with
+// TerraformSchema, for native and JSON files alike. Malformed blocks are left
+// for Terraform to report, so they are missing rather than failing the rule.
+func (s *SourceFile) Blocks() hcl.Blocks {
+	s.blocksOnce.Do(func() {
+		content, _, _ := s.file.Body.PartialContent(TerraformSchema)
+		s.blocks = content.Blocks
+	})
+	return s.blocks
+}
+
+// CommentMap returns the comments of the file attached to the blocks and
+// attributes they describe. A JSON file, or one that fails to lex, has none.
+func (s *SourceFile) CommentMap() *CommentMap {
+	s.commentsOnce.Do(func() {
+		tokens, diags := s.Tokens()
+		body, ok := s.file.Body.(*hclsyntax.Body)
+		if !ok || diags.HasErrors() {
+			tokens, body = nil, &hclsyntax.Body{}
+		}
+		s.commentMap = NewCommentMap(tokens, body)
+	})
+	return s.commentMap
+}
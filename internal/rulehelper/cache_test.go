@@ -0,0 +1,185 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rulehelper
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+func parseTestFile(t testing.TB, filename string, src string) *hcl.File {
+	t.Helper()
+	parser := hclparse.NewParser()
+	var file *hcl.File
+	var diags hcl.Diagnostics
+	if strings.HasSuffix(filename, ".json") {
+		file, diags = parser.ParseJSON([]byte(src), filename)
+	} else {
+		file, diags = parser.ParseHCL([]byte(src), filename)
+	}
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+	return file
+}
+
+func TestSource(t *testing.T) {
+	resetSources()
+	t.Cleanup(resetSources)
+
+	src := `# The web tier.
+resource "aws_instance" "web" {
+  ami = "ami-123" # pinned
+}
+`
+	first := Source("main.tf", parseTestFile(t, "main.tf", src))
+	if again := Source("main.tf", parseTestFile(t, "main.tf", src)); again != first {
+		t.Error("same content wasn't served from the cache")
+	}
+	if other := Source("other.tf", parseTestFile(t, "other.tf", src)); other == first {
+		t.Error("another file shared the entry of main.tf")
+	}
+
+	tokens, diags := first.Tokens()
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+	var comments []string
+	for _, i := range first.Comments() {
+		if tokens[i].Type != hclsyntax.TokenComment {
+			t.Fatalf("index %d is a %s, not a comment", i, tokens[i].Type)
+		}
+		comments = append(comments, strings.TrimSpace(string(tokens[i].Bytes)))
+	}
+	if got := strings.Join(comments, "|"); got != "# The web tier.|# pinned" {
+		t.Errorf("comments = %q", got)
+	}
+	if blocks := first.Blocks(); len(blocks) != 1 || blocks[0].Labels[1] != "web" {
+		t.Errorf("blocks = %v", blocks)
+	}
+
+	changed := Source("main.tf", parseTestFile(t, "main.tf", strings.Replace(src, `"web"`, `"api"`, 1)))
+	if changed == first {
+		t.Fatal("changed content was served from the cache")
+	}
+	if blocks := changed.Blocks(); len(blocks) != 1 || blocks[0].Labels[1] != "api" {
+		t.Errorf("blocks = %v", blocks)
+	}
+
+	json := Source("main.tf.json", parseTestFile(t, "main.tf.json", `{"variable": {"region": {}}}`))
+	if blocks := json.Blocks(); len(blocks) != 1 || blocks[0].Labels[0] != "region" {
+		t.Errorf("JSON blocks = %v", blocks)
+	}
+}
+
+// benchmarkFiles returns a module of n files of typical size.
+func benchmarkFiles(b *testing.B, n int) map[string]*hcl.File {
+	files := make(map[string]*hcl.File, n)
+	for i := 0; i < n; i++ {
+		var src strings.Builder
+		for j := 0; j < 10; j++ {
+			fmt.Fprintf(&src, `# Instance %d of the fleet.
+resource "aws_instance" "web_%d" {
+  count = var.enabled ? 1 : 0
+
+  ami           = "ami-123"
+  instance_type = "t3.micro" # TODO: size per env
+  user_data     = <<-EOT
+    #!/bin/bash
+    echo "web %d"
+  EOT
+
+  tags = {
+    Name = "web-${var.env}-%d"
+  }
+}
+
+`, j, j, j, j)
+		}
+		filename := fmt.Sprintf("file_%d.tf", i)
+		files[filename] = parseTestFile(b, filename, src.String())
+	}
+	return files
+}
+
+// tokenPasses is the number of times a run walks the tokens of each file: the
+// annotations, comments, the comments threshold, death_mask, heredoc and
+// reminder.
+const tokenPasses = 6
+
+// BenchmarkTokensPerRule lexes each file once per pass, as every rule did on
+// its own before the cache.
+func BenchmarkTokensPerRule(b *testing.B) {
+	files := benchmarkFiles(b, 100)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for pass := 0; pass < tokenPasses; pass++ {
+			for filename, file := range files {
+				if _, diags := hclsyntax.LexConfig(file.Bytes, filename, hcl.InitialPos); diags.HasErrors() {
+					b.Fatal(diags)
+				}
+			}
+		}
+	}
+}
+
+// BenchmarkTokensCached gets the tokens of each file from the cache on every
+// pass. The cache is emptied per iteration, so each one pays for a full run.
+func BenchmarkTokensCached(b *testing.B) {
+	files := benchmarkFiles(b, 100)
+	b.Cleanup(resetSources)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		resetSources()
+		for pass := 0; pass < tokenPasses; pass++ {
+			for filename, file := range files {
+				if _, diags := Source(filename, file).Tokens(); diags.HasErrors() {
+					b.Fatal(diags)
+				}
+			}
+		}
+	}
+}
+
+// blockPasses is the number of times a run decodes the top-level blocks of
+// each file: dry, hungarian, meta and naming.
+const blockPasses = 4
+
+// BenchmarkBlocksPerRule decodes the blocks of each file once per pass.
+func BenchmarkBlocksPerRule(b *testing.B) {
+	files := benchmarkFiles(b, 100)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for pass := 0; pass < blockPasses; pass++ {
+			for _, file := range files {
+				file.Body.PartialContent(TerraformSchema)
+			}
+		}
+	}
+}
+
+// BenchmarkBlocksCached gets the blocks of each file from the cache on every
+// pass.
+func BenchmarkBlocksCached(b *testing.B) {
+	files := benchmarkFiles(b, 100)
+	b.Cleanup(resetSources)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		resetSources()
+		for pass := 0; pass < blockPasses; pass++ {
+			for filename, file := range files {
+				Source(filename, file).Blocks()
+			}
+		}
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rulehelper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// BeginCheck starts the Check of rule. It decodes the rule block from the
+// config TFLint loaded into config, and returns the runner the checks emit
+// their issues through along with the override blocks of the rule block. The
+// runner is nil if the rule isn't enabled, which happens when the EOS plugin is
+// enabled but this rule is not.
+func BeginCheck[C any](runner tflint.Runner, rule tflint.Rule, config *C) (*IgnoreRunner, Overrides[C], error) {
+	overrides, err := DecodeRuleConfig(runner, rule.Name(), config)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !rule.Enabled() {
+		return nil, overrides, nil
+	}
+
+	ignores, err := NewIgnoreRunner(runner, rule)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ignores, overrides, nil
+}
+
+// EmitIssue emits an issue of the named sub-rule of rule at level, or at the
+// rule's severity if level is empty. An empty subRule emits the issue for the
+// rule as a whole. Errors are logged rather than returned, so one bad issue
+// doesn't stop the check.
+func EmitIssue(runner tflint.Runner, rule tflint.Rule, subRule string, level string, message string, rng hcl.Range) {
+	EmitIssueWithFix(runner, rule, subRule, level, message, rng, nil)
+}
+
+// EmitIssueWithFix is EmitIssue with a fix. A nil fixFunc emits the issue
+// without one.
+func EmitIssueWithFix(runner tflint.Runner, rule tflint.Rule, subRule string, level string, message string, rng hcl.Range, fixFunc func(tflint.Fixer) error) {
+	if subRule != "" {
+		runner = SubRule(runner, subRule)
+	}
+	rule = WithLevel(rule, level)
+
+	var err error
+	if fixFunc == nil {
+		err = runner.EmitIssue(rule, message, rng)
+	} else {
+		err = runner.EmitIssueWithFix(rule, message, rng, fixFunc)
+	}
+	if err != nil {
+		logger.Error(err.Error())
+	}
+	logger.Debug(message)
+}
+
+// DescribeBlock names a block in messages, e.g. "Resource 'aws_instance.web'"
+// or "Terraform block".
+func DescribeBlock(block *hcl.Block) string {
+	typ := Capitalize(block.Type)
+	if len(block.Labels) == 0 {
+		return typ + " block"
+	}
+	return fmt.Sprintf("%s '%s'", typ, strings.Join(block.Labels, "."))
+}
+
+// Capitalize returns text with its first letter in upper case.
+func Capitalize(text string) string {
+	if text == "" {
+		return text
+	}
+	return strings.ToUpper(text[:1]) + text[1:]
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rulehelper
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+func TestDescribeBlock(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Block    *hcl.Block
+		Expected string
+	}{
+		{
+			Name:     "labels",
+			Block:    &hcl.Block{Type: "resource", Labels: []string{"aws_instance", "web"}},
+			Expected: "Resource 'aws_instance.web'",
+		},
+		{
+			Name:     "no_labels",
+			Block:    &hcl.Block{Type: "terraform"},
+			Expected: "Terraform block",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if got := DescribeBlock(tc.Block); got != tc.Expected {
+				t.Errorf("DescribeBlock() = %q, expected %q", got, tc.Expected)
+			}
+		})
+	}
+}
+
+func TestEmitIssue(t *testing.T) {
+	runner := helper.TestRunner(t, map[string]string{"test.tf": ""})
+	rule := &ignoreTestRule{}
+	rng := hcl.Range{Filename: "test.tf"}
+
+	EmitIssue(runner, rule, "", "", "plain", rng)
+	EmitIssue(runner, rule, "upper", "error", "leveled", rng)
+	EmitIssueWithFix(runner, rule, "long", "", "fixed", rng, func(tflint.Fixer) error { return nil })
+
+	expected := []tflint.Severity{tflint.WARNING, tflint.ERROR, tflint.WARNING}
+	if len(runner.Issues) != len(expected) {
+		t.Fatalf("Expected %d issues, got %d", len(expected), len(runner.Issues))
+	}
+	for i, issue := range runner.Issues {
+		if got := issue.Rule.Severity(); got != expected[i] {
+			t.Errorf("Issue %q has severity %v, expected %v", issue.Message, got, expected[i])
+		}
+	}
+}
@@ -7,91 +7,296 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/hcl/v2"
-	"github.com/hashicorp/hcl/v2/gohcl"
 	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 )
 
-// FindConfigFile searches for .tflint.hcl in CWD first, then $HOME. Returns the
-// path if found, or an error describing where it looked.
-func FindConfigFile() (string, error) {
-	var searched []string
+// Validator is implemented by rule configs that check their values once they
+// are decoded, e.g. that a ratio lies within 0..1.
+type Validator interface {
+	Validate() error
+}
+
+// validLevels are the accepted issue levels.
+var validLevels = []string{"notice", "warning", "error"}
 
-	// Check CWD.
-	cwd, err := os.Getwd()
+// DecodeRuleConfig decodes the rule block named ruleName into target through
+// the runner, then validates it. An unknown key is reported with the valid
+// keys and the closest match, levels must be valid, and a target implementing
+// Validator checks its own values. The override blocks of the rule block are
+// returned, each decoded and validated the same way.
+func DecodeRuleConfig[C any](runner tflint.Runner, ruleName string, target *C) (Overrides[C], error) {
+	overrides, err := decodeWithOverrides(runner, ruleName, target)
 	if err != nil {
-		return "", fmt.Errorf("failed to get CWD: %w", err)
+		return nil, unsupportedKeyError(ruleName, target, err)
 	}
-	cwdConfig := filepath.Join(cwd, ".tflint.hcl")
-	searched = append(searched, cwdConfig)
-	if _, err2 := os.Stat(cwdConfig); err2 == nil {
-		return cwdConfig, nil
+
+	if err := validateConfig(target); err != nil {
+		return nil, fmt.Errorf("rule %q: %w", ruleName, err)
+	}
+	for i := range overrides {
+		if err := validateConfig(&overrides[i].Config); err != nil {
+			return nil, fmt.Errorf("rule %q: override %d: %w", ruleName, i+1, err)
+		}
 	}
 
-	// Check HOME.
-	home, err := os.UserHomeDir()
-	if err != nil {
-		return "", fmt.Errorf("failed to get user home dir: %w", err)
+	return overrides, nil
+}
+
+// validateConfig checks the levels of a decoded config, then lets the config
+// check its own values.
+func validateConfig(config any) error {
+	if err := validateLevels(reflect.ValueOf(config), ""); err != nil {
+		return err
 	}
-	homeConfig := filepath.Join(home, ".tflint.hcl")
-	searched = append(searched, homeConfig)
-	if _, err := os.Stat(homeConfig); err == nil {
-		return homeConfig, nil
+	if v, ok := config.(Validator); ok {
+		return v.Validate()
+	}
+	return nil
+}
+
+// unsupportedKeyError rewrites the error of a rule block holding an unknown
+// key to name the rule, suggest the closest valid key and list them all. TFLint
+// rejects the block without handing its body to the plugin, so the unknown key
+// is found by reading the rule block from the config file and comparing its
+// keys with the schema. If the config file can't be found, or every key in it
+// is known, the error is returned as-is.
+func unsupportedKeyError(ruleName string, target any, err error) error {
+	body := ruleBody(ruleName)
+	if body == nil {
+		return err
+	}
+	schema := hclext.ImpliedBodySchema(target)
+	key, rng, ok := unknownKey(body, withOverride(schema))
+	if !ok {
+		return err
 	}
 
-	return "", fmt.Errorf("no .tflint.hcl found (searched: %v)", searched)
+	message := fmt.Sprintf("rule %q: unknown option %q at %s.", ruleName, key, rng)
+	keys := append(schemaKeys(schema), "override", "files")
+	if suggestion := closestKey(key, keys); suggestion != "" {
+		message += fmt.Sprintf(" Did you mean %q?", suggestion)
+	}
+	return fmt.Errorf("%s Valid options: %s, override { files, ... }.", message, describeSchema(schema))
 }
 
-// LoadRuleConfig loads the configuration for a specific rule from a config
-// file. If configFile is empty, it searches CWD then $HOME for .tflint.hcl.
-func LoadRuleConfig(ruleName string, targetConfig interface{}, configFile string) error {
-	// Determine config file path.
-	configPath := configFile
-	if configPath == "" {
-		var err error
-		configPath, err = FindConfigFile()
-		if err != nil {
-			return err
+// configFile returns the path of the TFLint config file, looked up the way
+// TFLint does: TFLINT_CONFIG_FILE, then .tflint.hcl in the working directory,
+// then in the home directory. A file passed with --config isn't visible to a
+// plugin, so "" is returned if none of these exists.
+func configFile() string {
+	if path := os.Getenv("TFLINT_CONFIG_FILE"); path != "" {
+		return path
+	}
+	candidates := []string{".tflint.hcl"}
+	if home, err := os.UserHomeDir(); err == nil {
+		candidates = append(candidates, filepath.Join(home, ".tflint.hcl"))
+	}
+	for _, path := range candidates {
+		if _, err := os.Stat(path); err == nil {
+			return path
 		}
 	}
+	return ""
+}
+
+// ruleBody returns the body of the rule block named ruleName in the TFLint
+// config file, or nil if there is no such file or block.
+func ruleBody(ruleName string) *hclsyntax.Body {
+	path := configFile()
+	if path == "" {
+		return nil
+	}
+	file, diags := hclparse.NewParser().ParseHCLFile(path)
+	if diags.HasErrors() {
+		return nil
+	}
+	body, ok := file.Body.(*hclsyntax.Body)
+	if !ok {
+		return nil
+	}
 
-	// Read and parse the config file.
-	content, err := os.ReadFile(configPath)
-	if err != nil {
-		return fmt.Errorf("failed to read config file %s: %w", configPath, err)
+	for _, block := range body.Blocks {
+		if block.Type == "rule" && len(block.Labels) == 1 && block.Labels[0] == ruleName {
+			return block.Body
+		}
 	}
+	return nil
+}
 
-	parser := hclparse.NewParser()
-	file, diags := parser.ParseHCL(content, configPath)
-	if diags.HasErrors() {
-		return fmt.Errorf("failed to parse config %s: %s", configPath, diags)
+// withOverride returns schema with the override block the rule block may hold,
+// i.e. the keys of schema plus files.
+func withOverride(schema *hclext.BodySchema) *hclext.BodySchema {
+	override := *schema
+	override.Attributes = append(append([]hclext.AttributeSchema(nil), schema.Attributes...), hclext.AttributeSchema{Name: "files"})
+
+	wrapper := *schema
+	wrapper.Blocks = append(append([]hclext.BlockSchema(nil), schema.Blocks...), hclext.BlockSchema{Type: "override", Body: &override})
+	return &wrapper
+}
+
+// unknownKey returns the first key of body, in source order, that schema
+// doesn't declare, looking into the nested blocks schema declares too. ok is
+// false if every key is known.
+func unknownKey(body *hclsyntax.Body, schema *hclext.BodySchema) (key string, rng hcl.Range, ok bool) {
+	known := map[string]bool{}
+	for _, attr := range schema.Attributes {
+		known[attr.Name] = true
+	}
+	nested := map[string]*hclext.BodySchema{}
+	for _, block := range schema.Blocks {
+		nested[block.Type] = block.Body
 	}
 
-	// Decode the top-level structure.
-	var config struct {
-		Plugins []struct {
-			Name string   `hcl:"name,label"`
-			Body hcl.Body `hcl:",remain"`
-		} `hcl:"plugin,block"`
-		Rules []struct {
-			Name string   `hcl:"name,label"`
-			Body hcl.Body `hcl:",remain"`
-		} `hcl:"rule,block"`
+	found := func(name string, nameRange hcl.Range) {
+		if !ok || nameRange.Start.Byte < rng.Start.Byte {
+			key, rng, ok = name, nameRange, true
+		}
 	}
 
-	if diags := gohcl.DecodeBody(file.Body, nil, &config); diags.HasErrors() {
-		return fmt.Errorf("failed to decode config %s: %s", configPath, diags)
+	for name, attr := range body.Attributes {
+		if !known[name] {
+			found(name, attr.NameRange)
+		}
 	}
+	for _, block := range body.Blocks {
+		blockSchema, declared := nested[block.Type]
+		if !declared {
+			found(block.Type, block.TypeRange)
+			continue
+		}
+		if blockSchema == nil {
+			continue
+		}
+		if name, nameRange, unknown := unknownKey(block.Body, blockSchema); unknown {
+			found(name, nameRange)
+		}
+	}
+
+	return key, rng, ok
+}
+
+// schemaKeys returns every attribute and block name in schema, including those
+// of nested blocks.
+func schemaKeys(schema *hclext.BodySchema) []string {
+	var keys []string
+	for _, attr := range schema.Attributes {
+		keys = append(keys, attr.Name)
+	}
+	for _, block := range schema.Blocks {
+		keys = append(keys, block.Type)
+		if block.Body != nil {
+			keys = append(keys, schemaKeys(block.Body)...)
+		}
+	}
+	return keys
+}
+
+// describeSchema lists the keys of schema, with the keys of each nested block
+// in braces, e.g. "eol, length { column, level }, level".
+func describeSchema(schema *hclext.BodySchema) string {
+	var keys []string
+	for _, attr := range schema.Attributes {
+		keys = append(keys, attr.Name)
+	}
+	for _, block := range schema.Blocks {
+		key := block.Type
+		if block.Body != nil && (len(block.Body.Attributes) > 0 || len(block.Body.Blocks) > 0) {
+			key += " { " + describeSchema(block.Body) + " }"
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ", ")
+}
+
+// closestKey returns the key nearest to name by edit distance, or "" if none
+// is close enough to be a plausible typo.
+func closestKey(name string, keys []string) string {
+	best := ""
+	bestDistance := 3
+	for _, key := range keys {
+		if d := editDistance(strings.ToLower(name), strings.ToLower(key)); d < bestDistance {
+			best = key
+			bestDistance = d
+		}
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr := make([]int, len(b)+1)
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev = curr
+	}
+	return prev[len(b)]
+}
+
+// validateLevels walks a decoded config and checks that every level attribute
+// names a valid level and every SubCheck holds a bool or a level. path is the
+// dotted key of v, used in the error.
+func validateLevels(v reflect.Value, path string) error {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			if err := validateLevels(v.Index(i), path); err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			name, _, _ := strings.Cut(v.Type().Field(i).Tag.Get("hclext"), ",")
+			if name == "" {
+				continue
+			}
+			key := name
+			if path != "" {
+				key = path + "." + name
+			}
 
-	// Find and decode the specific rule. If not found, the caller's config
-	// struct retains its default values.
-	for _, r := range config.Rules {
-		if r.Name == ruleName {
-			if diags := gohcl.DecodeBody(r.Body, nil, targetConfig); diags.HasErrors() {
-				return fmt.Errorf("failed to decode rule %s in %s: %s", ruleName, configPath, diags)
+			field := v.Field(i)
+			switch {
+			case field.Type() == reflect.TypeOf(SubCheck("")):
+				value := string(field.Interface().(SubCheck))
+				if value != "" && value != "true" && value != "false" && SubCheck(value).Level() == "" {
+					return fmt.Errorf("%q must be true, false or a level (%s), not %q", key, strings.Join(validLevels, ", "), value)
+				}
+			case name == "level" && field.Kind() == reflect.String:
+				if value := field.String(); value != "" && SubCheck(value).Level() == "" {
+					return fmt.Errorf("%q must be one of %s, not %q", key, strings.Join(validLevels, ", "), value)
+				}
+			default:
+				if err := validateLevels(field, key); err != nil {
+					return err
+				}
 			}
-			break
 		}
 	}
 
@@ -0,0 +1,251 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rulehelper
+
+import (
+	"os"
+	"testing"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+// configTestConfig exercises nested blocks, levels and sub-checks.
+type configTestConfig struct {
+	Enabled *bool    `hclext:"enabled,optional" hcl:"enabled,optional"`
+	Level   string   `hclext:"level,optional" hcl:"level,optional"`
+	Shout   SubCheck `hclext:"shout,optional" hcl:"shout,optional"`
+	Length  *struct {
+		Column int    `hclext:"column,optional" hcl:"column,optional"`
+		Level  string `hclext:"level,optional" hcl:"level,optional"`
+	} `hclext:"length,block" hcl:"length,block"`
+}
+
+func TestDecodeRuleConfig(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Config string
+		Want   string
+	}{
+		{
+			Name: "valid",
+			Config: `rule "eos_fake" {
+  enabled = true
+  shout   = "notice"
+  length {
+    level = "error"
+  }
+}`,
+		},
+		{
+			Name: "typo",
+			Config: `rule "eos_fake" {
+  enabled = true
+  levle   = "error"
+}`,
+			Want: `rule "eos_fake": unknown option "levle" at .tflint.hcl:3,3-8. Did you mean "level"? Valid options: enabled, length { column, level }, level, shout, override { files, ... }.`,
+		},
+		{
+			Name: "no_suggestion",
+			Config: `rule "eos_fake" {
+  enabled = true
+  colour  = "red"
+}`,
+			Want: `rule "eos_fake": unknown option "colour" at .tflint.hcl:3,3-9. Valid options: enabled, length { column, level }, level, shout, override { files, ... }.`,
+		},
+		{
+			Name: "nested_typo",
+			Config: `rule "eos_fake" {
+  enabled = true
+  length {
+    colum = 80
+  }
+}`,
+			Want: `rule "eos_fake": unknown option "colum" at .tflint.hcl:4,5-10. Did you mean "column"? Valid options: enabled, length { column, level }, level, shout, override { files, ... }.`,
+		},
+		{
+			Name: "unknown_block",
+			Config: `rule "eos_fake" {
+  enabled = true
+  level   = "error"
+  width {
+    column = 80
+  }
+}`,
+			Want: `rule "eos_fake": unknown option "width" at .tflint.hcl:4,3-8. Valid options: enabled, length { column, level }, level, shout, override { files, ... }.`,
+		},
+		{
+			Name: "nested_level",
+			Config: `rule "eos_fake" {
+  enabled = true
+  length {
+    level = "fatal"
+  }
+}`,
+			Want: `rule "eos_fake": "length.level" must be one of notice, warning, error, not "fatal"`,
+		},
+		{
+			Name: "sub_check",
+			Config: `rule "eos_fake" {
+  enabled = true
+  shout   = "yes"
+}`,
+			Want: `rule "eos_fake": "shout" must be true, false or a level (notice, warning, error), not "yes"`,
+		},
+		{
+			Name: "override_typo",
+			Config: `rule "eos_fake" {
+  enabled = true
+  override {
+    file  = ["a/**"]
+    shout = false
+  }
+}`,
+			Want: `rule "eos_fake": unknown option "file" at .tflint.hcl:4,5-9. Did you mean "files"? Valid options: enabled, length { column, level }, level, shout, override { files, ... }.`,
+		},
+		{
+			Name: "override_level",
+			Config: `rule "eos_fake" {
+  enabled = true
+  override {
+    files = ["a/**"]
+    level = "loud"
+  }
+}`,
+			Want: `rule "eos_fake": override 1: "level" must be one of notice, warning, error, not "loud"`,
+		},
+		{
+			Name: "override_pattern",
+			Config: `rule "eos_fake" {
+  enabled = true
+  override {
+    files = ["a/[b"]
+  }
+}`,
+			Want: `rule "eos_fake": override 1: invalid files pattern "a/[b"`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			// Unknown keys are found by reading the config file TFLint loaded.
+			t.Chdir(t.TempDir())
+			if err := os.WriteFile(".tflint.hcl", []byte(tc.Config), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			runner := helper.TestRunner(t, map[string]string{".tflint.hcl": tc.Config})
+
+			var config configTestConfig
+			_, err := DecodeRuleConfig(runner, "eos_fake", &config)
+			got := ""
+			if err != nil {
+				got = err.Error()
+			}
+			if got != tc.Want {
+				t.Errorf("error mismatch:\nwant: %s\ngot:  %s", tc.Want, got)
+			}
+		})
+	}
+}
+
+func TestDecodeRuleConfigWithoutConfigFile(t *testing.T) {
+	// With no config file to read, e.g. one passed with --config, TFLint's
+	// error is returned as-is.
+	dir := t.TempDir()
+	t.Chdir(dir)
+	t.Setenv("HOME", dir)
+	t.Setenv("TFLINT_CONFIG_FILE", "")
+	runner := helper.TestRunner(t, map[string]string{".tflint.hcl": `rule "eos_fake" {
+  enabled = true
+  levle   = "error"
+}`})
+
+	var config configTestConfig
+	_, err := DecodeRuleConfig(runner, "eos_fake", &config)
+	want := `.tflint.hcl:3,3-8: Unsupported argument; An argument named "levle" is not expected here. Did you mean "level"?`
+	if err == nil || err.Error() != want {
+		t.Errorf("error mismatch:\nwant: %s\ngot:  %v", want, err)
+	}
+}
+
+func TestDecodeRuleConfigOverrides(t *testing.T) {
+	runner := helper.TestRunner(t, map[string]string{".tflint.hcl": `rule "eos_fake" {
+  enabled = true
+  level   = "warning"
+  length {
+    column = 80
+  }
+  override {
+    files = ["modules/legacy/**"]
+    length {
+      column = 120
+    }
+  }
+  override {
+    files = ["modules/legacy/generated.tf", "examples/*.tf"]
+    shout = false
+  }
+}`})
+
+	config := configTestConfig{Shout: "notice"}
+	overrides, err := DecodeRuleConfig(runner, "eos_fake", &config)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if config.Length.Column != 80 || config.Shout != "notice" {
+		t.Errorf("Rule config changed by overrides: %+v", config)
+	}
+
+	cases := []struct {
+		Filename string
+		Matched  bool
+		Column   int
+		Shout    SubCheck
+	}{
+		{Filename: "main.tf", Matched: false},
+		{Filename: "modules/legacy/main.tf", Matched: true, Column: 120, Shout: "notice"},
+		{Filename: "modules/legacy/nested/main.tf", Matched: true, Column: 120, Shout: "notice"},
+		{Filename: "modules/legacy/generated.tf", Matched: true, Column: 80, Shout: "false"},
+		{Filename: "examples/basic.tf", Matched: true, Column: 80, Shout: "false"},
+		{Filename: "examples/nested/basic.tf", Matched: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Filename, func(t *testing.T) {
+			got, ok := overrides.Match(tc.Filename)
+			if ok != tc.Matched {
+				t.Fatalf("Match = %t, want %t", ok, tc.Matched)
+			}
+			if !ok {
+				return
+			}
+			if got.Length.Column != tc.Column || got.Shout != tc.Shout || got.Level != "warning" {
+				t.Errorf("Unexpected config: column %d, shout %q, level %q", got.Length.Column, got.Shout, got.Level)
+			}
+		})
+	}
+}
+
+func TestMatchGlob(t *testing.T) {
+	cases := []struct {
+		Pattern  string
+		Filename string
+		Want     bool
+	}{
+		{Pattern: "**", Filename: "main.tf", Want: true},
+		{Pattern: "*.tf", Filename: "main.tf", Want: true},
+		{Pattern: "*.tf", Filename: "modules/main.tf", Want: false},
+		{Pattern: "**/*.tf", Filename: "modules/a/main.tf", Want: true},
+		{Pattern: "examples/**", Filename: "examples/a/b/main.tf", Want: true},
+		{Pattern: "./examples/**", Filename: "examples/main.tf", Want: true},
+		{Pattern: "examples/**", Filename: "example/main.tf", Want: false},
+		{Pattern: "modules/**/test_*.tf", Filename: "modules/test_a.tf", Want: true},
+	}
+
+	for _, tc := range cases {
+		if got := MatchGlob(tc.Pattern, tc.Filename); got != tc.Want {
+			t.Errorf("MatchGlob(%q, %q) = %t, want %t", tc.Pattern, tc.Filename, got, tc.Want)
+		}
+	}
+}
@@ -25,3 +25,45 @@ func ToSeverity(level string) tflint.Severity {
 
 	return tflint.ERROR
 }
+
+// SubCheck is a sub-check setting that takes either a bool, which switches the
+// check on or off, or an issue level, which switches it on at that level. HCL
+// converts bools to strings, so `eol = true` and `eol = "notice"` both decode.
+// An unset SubCheck is enabled.
+type SubCheck string
+
+// Enabled reports whether the sub-check is switched on.
+func (s SubCheck) Enabled() bool {
+	return !strings.EqualFold(string(s), "false")
+}
+
+// Level returns the issue level set for the sub-check, or "" if the setting
+// is a bool.
+func (s SubCheck) Level() string {
+	switch strings.ToLower(string(s)) {
+	case "notice", "warning", "error":
+		return string(s)
+	}
+	return ""
+}
+
+// leveledRule reports a rule's issues at a sub-check's severity.
+type leveledRule struct {
+	tflint.Rule
+	severity tflint.Severity
+}
+
+// Severity returns the sub-check severity.
+func (r *leveledRule) Severity() tflint.Severity {
+	return r.severity
+}
+
+// WithLevel returns rule with its severity replaced by level, for emitting the
+// issues of a sub-check that sets its own level. If level is empty, rule is
+// returned unchanged.
+func WithLevel(rule tflint.Rule, level string) tflint.Rule {
+	if level == "" {
+		return rule
+	}
+	return &leveledRule{Rule: rule, severity: ToSeverity(level)}
+}
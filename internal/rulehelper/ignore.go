@@ -0,0 +1,296 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rulehelper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/terraform"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ignoreMarker introduces an eos-ignore annotation, e.g.
+//
+//	# eos-ignore: naming.type_echo, comments.eol -- generated by codegen
+const ignoreMarker = "eos-ignore:"
+
+// annotation is a parsed eos-ignore comment.
+type annotation struct {
+	rng hcl.Range
+	// Targets are "rule" or "rule.sub_rule", without the eos_ prefix.
+	targets []string
+	reason  string
+	// The first and last lines whose issues the annotation covers.
+	startLine int
+	endLine   int
+	used      []bool
+}
+
+// IgnoreRunner wraps the runner handed to a rule's Check. Issues emitted
+// through it are dropped when an eos-ignore annotation names the rule, or the
+// sub-rule set with SubRule, and covers the issue's line. The others go on to
+// the runner it wraps, which NewIgnoreRunner stacks with a BaselineRunner and a
+// ModuleRunner as the plugin config asks.
+type IgnoreRunner struct {
+	tflint.Runner
+	rule    tflint.Rule
+	subRule string
+	// Annotations by filename, shared by every sub-rule runner of the rule.
+	annotations map[string][]*annotation
+	// requireReason drops annotations without a "-- reason".
+	requireReason bool
+	reportUnused  bool
+	// baseline is nil when there is no baseline file.
+	baseline *BaselineRunner
+}
+
+// NewIgnoreRunner returns the runner rule checks through. It parses the
+// eos-ignore annotations in the module and applies them to the issues of rule.
+// When runner carries the plugin config, the mandatory-reason and unused report
+// modes are taken from it, and issues pass through a BaselineRunner if there is
+// a baseline, and a ModuleRunner if local modules are linted.
+func NewIgnoreRunner(runner tflint.Runner, rule tflint.Rule) (*IgnoreRunner, error) {
+	ignoreRunner := &IgnoreRunner{
+		Runner:      runner,
+		rule:        rule,
+		annotations: map[string][]*annotation{},
+	}
+	if tr, ok := runner.(*terraform.Runner); ok && tr.Config != nil {
+		ignoreRunner.requireReason = tr.Config.RequireIgnoreReason
+		ignoreRunner.reportUnused = tr.Config.ReportUnusedIgnores
+
+		if tr.Config.LocalModules {
+			moduleRunner, err := NewModuleRunner(tr)
+			if err != nil {
+				return nil, err
+			}
+			ignoreRunner.Runner = moduleRunner
+		}
+
+		baselineRunner, err := NewBaselineRunner(ignoreRunner.Runner, rule, tr)
+		if err != nil {
+			return nil, err
+		}
+		if baselineRunner != nil {
+			ignoreRunner.Runner = baselineRunner
+			ignoreRunner.baseline = baselineRunner
+		}
+	}
+
+	files, err := ignoreRunner.GetFiles()
+	if err != nil {
+		return nil, err
+	}
+	for filename, file := range files {
+		// JSON configurations have no comments to annotate.
+		body, ok := file.Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+		annotations, diags := parseAnnotations(Source(filename, file), body)
+		if diags.HasErrors() {
+			return nil, diags
+		}
+		ignoreRunner.annotations[filename] = annotations
+	}
+
+	return ignoreRunner, nil
+}
+
+// SubRule returns a runner that attributes the issues it emits to the named
+// sub-rule, so that an annotation such as "naming.type_echo" can single them
+// out. Runners that are not an IgnoreRunner are returned unchanged.
+func SubRule(runner tflint.Runner, name string) tflint.Runner {
+	ignoreRunner, ok := runner.(*IgnoreRunner)
+	if !ok {
+		return runner
+	}
+	sub := *ignoreRunner
+	sub.subRule = name
+	return &sub
+}
+
+// EmitIssue emits the issue unless an annotation covers it.
+func (r *IgnoreRunner) EmitIssue(rule tflint.Rule, message string, issueRange hcl.Range) error {
+	if r.ignored(issueRange) {
+		return nil
+	}
+	return r.Runner.EmitIssue(rule, message, issueRange)
+}
+
+// EmitIssueWithFix emits the issue and its fix unless an annotation covers it.
+func (r *IgnoreRunner) EmitIssueWithFix(rule tflint.Rule, message string, issueRange hcl.Range, fixFunc func(f tflint.Fixer) error) error {
+	if r.ignored(issueRange) {
+		return nil
+	}
+	return r.Runner.EmitIssueWithFix(rule, message, issueRange, fixFunc)
+}
+
+// ignored reports whether an annotation covers rng for the current rule and
+// sub-rule, and marks the matching targets as used.
+func (r *IgnoreRunner) ignored(rng hcl.Range) bool {
+	ignored := false
+	for _, a := range r.annotations[rng.Filename] {
+		if rng.Start.Line < a.startLine || rng.Start.Line > a.endLine {
+			continue
+		}
+		if r.requireReason && a.reason == "" {
+			continue
+		}
+		for i, target := range a.targets {
+			if r.matches(target, true) {
+				a.used[i] = true
+				ignored = true
+			}
+		}
+	}
+	return ignored
+}
+
+// matches reports whether target names the rule and, if subRule is set and
+// the target names a sub-rule, the current sub-rule.
+func (r *IgnoreRunner) matches(target string, subRule bool) bool {
+	ruleName, sub, _ := strings.Cut(target, ".")
+	if ruleName != strings.TrimPrefix(r.rule.Name(), "eos_") {
+		return false
+	}
+	return !subRule || sub == "" || sub == r.subRule
+}
+
+// ReportIgnores emits an issue for every annotation target naming the rule
+// that is missing its mandatory reason or, when reporting unused suppressions,
+// that no issue matched. The baseline then reports its stale entries. It is
+// called once the rule's checks have run.
+func (r *IgnoreRunner) ReportIgnores() error {
+	for _, annotations := range r.annotations {
+		for _, a := range annotations {
+			for i, target := range a.targets {
+				if !r.matches(target, false) {
+					continue
+				}
+				var message string
+				switch {
+				case r.requireReason && a.reason == "":
+					message = fmt.Sprintf("Add a reason to eos-ignore '%s' ('-- why').", target)
+				case r.reportUnused && !a.used[i]:
+					message = fmt.Sprintf("Remove unused eos-ignore '%s'.", target)
+				default:
+					continue
+				}
+				if err := r.Runner.EmitIssue(r.rule, message, a.rng); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if r.baseline == nil {
+		return nil
+	}
+	return r.baseline.ReportStale()
+}
+
+// parseAnnotations finds the eos-ignore comments in a file. An annotation that
+// trails code covers its own line. One on a line of its own covers the lines
+// down to the next line of code. Either way, when a block or attribute starts
+// on the covered line of code, the annotation covers all of it.
+func parseAnnotations(source *SourceFile, body *hclsyntax.Body) ([]*annotation, hcl.Diagnostics) {
+	tokens, diags := source.Tokens()
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	var annotations []*annotation
+	for _, i := range source.Comments() {
+		token := tokens[i]
+		a := parseAnnotation(string(token.Bytes))
+		if a == nil {
+			continue
+		}
+		a.rng = token.Range
+		a.startLine = token.Range.Start.Line
+
+		// An annotation that trails code covers that line, otherwise the next
+		// line holding code.
+		codeLine := a.startLine
+		if i == 0 || tokens[i-1].Type == hclsyntax.TokenNewline || tokens[i-1].Type == hclsyntax.TokenComment ||
+			tokens[i-1].Range.End.Line != a.startLine {
+			for _, next := range tokens[i+1:] {
+				if next.Type != hclsyntax.TokenComment && next.Type != hclsyntax.TokenNewline && next.Type != hclsyntax.TokenEOF {
+					codeLine = next.Range.Start.Line
+					break
+				}
+			}
+		}
+		a.endLine = codeLine
+		if end := itemEndLine(body, codeLine); end > a.endLine {
+			a.endLine = end
+		}
+
+		annotations = append(annotations, a)
+	}
+	return annotations, nil
+}
+
+// parseAnnotation parses the text of a comment token, returning nil if it is
+// not an eos-ignore annotation.
+func parseAnnotation(text string) *annotation {
+	text = strings.TrimSpace(text)
+	text = strings.TrimSuffix(text, "*/")
+	text = strings.TrimLeft(text, "#/*")
+	text = strings.TrimSpace(text)
+
+	rest, ok := strings.CutPrefix(text, ignoreMarker)
+	if !ok {
+		return nil
+	}
+	list, reason, _ := strings.Cut(rest, "--")
+
+	a := &annotation{reason: strings.TrimSpace(reason)}
+	for _, target := range strings.Split(list, ",") {
+		target = strings.TrimPrefix(strings.TrimSpace(target), "eos_")
+		if target != "" {
+			a.targets = append(a.targets, target)
+		}
+	}
+	a.used = make([]bool, len(a.targets))
+	return a
+}
+
+// IsAnnotation reports whether a comment, with or without its comment
+// markers, is an annotation for a linter rather than prose, e.g. tflint-ignore
+// or eos-ignore.
+func IsAnnotation(comment string) bool {
+	text := strings.TrimLeft(strings.TrimSpace(comment), "#/* \t")
+	for _, prefix := range []string{"tflint-ignore", strings.TrimSuffix(ignoreMarker, ":")} {
+		if strings.HasPrefix(text, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// itemEndLine returns the last line of the outermost block or attribute that
+// starts on line, or 0 if none does.
+func itemEndLine(body *hclsyntax.Body, line int) int {
+	for _, attr := range body.Attributes {
+		if attr.SrcRange.Start.Line == line {
+			return attr.SrcRange.End.Line
+		}
+	}
+	for _, block := range body.Blocks {
+		rng := block.Range()
+		if rng.Start.Line == line {
+			return rng.End.Line
+		}
+		if rng.Start.Line < line && line <= rng.End.Line {
+			return itemEndLine(block.Body, line)
+		}
+	}
+	return 0
+}
@@ -0,0 +1,246 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rulehelper
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/terraform"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ignoreTestRule has two block sub-rules, "upper" and "long", and a token
+// sub-rule, "todo".
+type ignoreTestRule struct {
+	tflint.DefaultRule
+}
+
+func (r *ignoreTestRule) Name() string              { return "eos_fake" }
+func (r *ignoreTestRule) Enabled() bool             { return true }
+func (r *ignoreTestRule) Severity() tflint.Severity { return tflint.WARNING }
+func (r *ignoreTestRule) Check(tflint.Runner) error { return nil }
+
+func checkIgnoreUpper(runner tflint.Runner, r *ignoreTestRule, rng hcl.Range, _ string, name string, _ string) {
+	if strings.ToLower(name) != name {
+		_ = SubRule(runner, "upper").EmitIssue(r, "upper", rng)
+	}
+}
+
+func checkIgnoreLong(runner tflint.Runner, r *ignoreTestRule, rng hcl.Range, _ string, name string, _ string) {
+	if len(name) > 5 {
+		_ = SubRule(runner, "long").EmitIssue(r, "long", rng)
+	}
+}
+
+func checkIgnoreTodo(runner tflint.Runner, r *ignoreTestRule, token hclsyntax.Token) {
+	if token.Type == hclsyntax.TokenComment && strings.Contains(string(token.Bytes), "TODO") {
+		_ = SubRule(runner, "todo").EmitIssue(r, "todo", token.Range)
+	}
+}
+
+func TestIgnore(t *testing.T) {
+	rule := &ignoreTestRule{}
+
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   *terraform.Config
+		Expected helper.Issues
+	}{
+		{
+			Name: "no_annotation",
+			Content: `variable "Longer" {}
+`,
+			Expected: helper.Issues{
+				{Rule: rule, Message: "upper", Range: hcl.Range{Filename: "test.tf", Start: hcl.Pos{Line: 1, Column: 1}, End: hcl.Pos{Line: 1, Column: 18}}},
+				{Rule: rule, Message: "long", Range: hcl.Range{Filename: "test.tf", Start: hcl.Pos{Line: 1, Column: 1}, End: hcl.Pos{Line: 1, Column: 18}}},
+			},
+		},
+		{
+			Name: "sub_rule",
+			Content: `# eos-ignore: fake.upper -- legacy name
+variable "Longer" {}
+`,
+			Expected: helper.Issues{
+				{Rule: rule, Message: "long", Range: hcl.Range{Filename: "test.tf", Start: hcl.Pos{Line: 2, Column: 1}, End: hcl.Pos{Line: 2, Column: 18}}},
+			},
+		},
+		{
+			Name: "whole_rule_with_prefix",
+			Content: `// eos-ignore: eos_fake
+variable "Longer" {}
+`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "several_targets",
+			Content: `# eos-ignore: fake.long, fake.upper, naming.snake
+variable "Longer" {}
+variable "Second" {}
+`,
+			Expected: helper.Issues{
+				{Rule: rule, Message: "upper", Range: hcl.Range{Filename: "test.tf", Start: hcl.Pos{Line: 3, Column: 1}, End: hcl.Pos{Line: 3, Column: 18}}},
+				{Rule: rule, Message: "long", Range: hcl.Range{Filename: "test.tf", Start: hcl.Pos{Line: 3, Column: 1}, End: hcl.Pos{Line: 3, Column: 18}}},
+			},
+		},
+		{
+			Name: "covers_block",
+			Content: `# eos-ignore: fake.todo
+
+# Other comment.
+resource "a" "b" {
+  # TODO: inside
+}
+# TODO: outside
+`,
+			Expected: helper.Issues{
+				{Rule: rule, Message: "todo", Range: hcl.Range{Filename: "test.tf", Start: hcl.Pos{Line: 7, Column: 1}, End: hcl.Pos{Line: 8, Column: 1}}},
+			},
+		},
+		{
+			Name: "trailing",
+			Content: `locals {
+  Longer = 1 # eos-ignore: fake.long
+  Second = 2
+}
+`,
+			Expected: helper.Issues{
+				{Rule: rule, Message: "upper", Range: hcl.Range{Filename: "test.tf", Start: hcl.Pos{Line: 2, Column: 3}, End: hcl.Pos{Line: 2, Column: 13}}},
+				{Rule: rule, Message: "upper", Range: hcl.Range{Filename: "test.tf", Start: hcl.Pos{Line: 3, Column: 3}, End: hcl.Pos{Line: 3, Column: 13}}},
+				{Rule: rule, Message: "long", Range: hcl.Range{Filename: "test.tf", Start: hcl.Pos{Line: 3, Column: 3}, End: hcl.Pos{Line: 3, Column: 13}}},
+			},
+		},
+		{
+			Name: "require_reason",
+			Content: `# eos-ignore: fake.upper
+variable "lower" {}
+# eos-ignore: fake.long -- generated
+variable "longer" {}
+`,
+			Config: &terraform.Config{RequireIgnoreReason: true},
+			Expected: helper.Issues{
+				{Rule: rule, Message: "Add a reason to eos-ignore 'fake.upper' ('-- why').", Range: hcl.Range{Filename: "test.tf", Start: hcl.Pos{Line: 1, Column: 1}, End: hcl.Pos{Line: 2, Column: 1}}},
+			},
+		},
+		{
+			Name: "report_unused",
+			Content: `# eos-ignore: fake.upper, fake.long, naming.snake -- stale
+variable "Lower" {}
+`,
+			Config: &terraform.Config{ReportUnusedIgnores: true},
+			Expected: helper.Issues{
+				{Rule: rule, Message: "Remove unused eos-ignore 'fake.long'.", Range: hcl.Range{Filename: "test.tf", Start: hcl.Pos{Line: 1, Column: 1}, End: hcl.Pos{Line: 2, Column: 1}}},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			var runner tflint.Runner = helper.TestRunner(t, map[string]string{"test.tf": tc.Content})
+			if tc.Config != nil {
+				runner = &terraform.Runner{Runner: runner, Config: tc.Config}
+			}
+
+			ignores, err := NewIgnoreRunner(runner, rule)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if err := WalkBlocks(ignores, AllLintableBlocks, rule, checkIgnoreUpper, checkIgnoreLong); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if err := WalkTokens(ignores, rule, checkIgnoreTodo); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if err := ignores.ReportIgnores(); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			if tr, ok := runner.(*terraform.Runner); ok {
+				runner = tr.Runner
+			}
+			helper.AssertIssues(t, tc.Expected, runner.(*helper.Runner).Issues)
+		})
+	}
+}
+
+func TestIgnoreLocalModules(t *testing.T) {
+	rule := &ignoreTestRule{}
+	content := `module "network" {
+  source = "./testdata/modules/network"
+}
+
+variable "Root" {}
+`
+
+	cases := []struct {
+		Name     string
+		Config   *terraform.Config
+		Expected []string
+	}{
+		{
+			Name:     "root_only",
+			Config:   &terraform.Config{},
+			Expected: []string{"test.tf:5"},
+		},
+		{
+			Name:   "local_modules",
+			Config: &terraform.Config{LocalModules: true},
+			Expected: []string{
+				"test.tf:5",
+				filepath.Join("testdata", "modules", "network", "main.tf") + ":1",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			testRunner := helper.TestRunner(t, map[string]string{"test.tf": content})
+			runner := &terraform.Runner{Runner: testRunner, Config: tc.Config}
+
+			ignores, err := NewIgnoreRunner(runner, rule)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if err := WalkBlocks(ignores, AllLintableBlocks, rule, checkIgnoreUpper); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			var got []string
+			for _, issue := range testRunner.Issues {
+				got = append(got, fmt.Sprintf("%s:%d", issue.Range.Filename, issue.Range.Start.Line))
+			}
+			sort.Strings(got)
+			sort.Strings(tc.Expected)
+			if diff := cmp.Diff(tc.Expected, got); diff != "" {
+				t.Errorf("issues mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsAnnotation(t *testing.T) {
+	cases := map[string]bool{
+		"# eos-ignore: naming -- generated":           true,
+		"// tflint-ignore: terraform_typed_variables": true,
+		"/* eos-ignore: size */":                      true,
+		"eos-ignore: comments.eol":                    true,
+		"# The eos-ignore annotations.":               false,
+		"# Ignore the tflint-ignore comments.":        false,
+	}
+
+	for comment, want := range cases {
+		if got := IsAnnotation(comment); got != want {
+			t.Errorf("IsAnnotation(%q) = %t, want %t", comment, got, want)
+		}
+	}
+}
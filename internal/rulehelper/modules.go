@@ -0,0 +1,80 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rulehelper
+
+import (
+	"fmt"
+
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/terraform"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ModuleRunner wraps a runner to add the files of the local modules the root
+// module calls to those it returns. Every rule reads files through the runner
+// NewIgnoreRunner returns, so every rule lints them along with the root's own.
+type ModuleRunner struct {
+	tflint.Runner
+	// moduleFiles are the files of local module calls, keyed by their path.
+	moduleFiles map[string]*hcl.File
+}
+
+// NewModuleRunner returns a runner adding the files of the local modules the
+// module of runner calls. A called module is inspected as part of its caller,
+// so it gets none.
+func NewModuleRunner(runner *terraform.Runner) (*ModuleRunner, error) {
+	path, err := runner.GetModulePath()
+	if err != nil {
+		return nil, err
+	}
+	moduleRunner := &ModuleRunner{Runner: runner}
+	if !path.IsRoot() {
+		return moduleRunner, nil
+	}
+
+	moduleRunner.moduleFiles, err = runner.LocalModuleFiles()
+	if err != nil {
+		return nil, err
+	}
+	return moduleRunner, nil
+}
+
+// GetFiles returns the files of the module together with those of its local
+// modules.
+func (r *ModuleRunner) GetFiles() (map[string]*hcl.File, error) {
+	files, err := r.Runner.GetFiles()
+	if err != nil || len(r.moduleFiles) == 0 {
+		return files, err
+	}
+
+	all := make(map[string]*hcl.File, len(files)+len(r.moduleFiles))
+	for filename, file := range r.moduleFiles {
+		all[filename] = file
+	}
+	for filename, file := range files {
+		all[filename] = file
+	}
+	return all, nil
+}
+
+// GetFile returns the named file, which may belong to a local module.
+func (r *ModuleRunner) GetFile(filename string) (*hcl.File, error) {
+	if file, ok := r.moduleFiles[filename]; ok {
+		return file, nil
+	}
+	return r.Runner.GetFile(filename)
+}
+
+// EmitIssueWithFix emits the issue and its fix. TFLint only fixes the files it
+// loaded, so an issue in a local module is reported without its fix, which is
+// logged as skipped.
+func (r *ModuleRunner) EmitIssueWithFix(rule tflint.Rule, message string, issueRange hcl.Range, fixFunc func(f tflint.Fixer) error) error {
+	if _, ok := r.moduleFiles[issueRange.Filename]; ok {
+		logger.Warn(fmt.Sprintf("%s: can't fix %s in a local module; run TFLint in the module to fix it", rule.Name(), issueRange))
+		return r.Runner.EmitIssue(rule, message, issueRange)
+	}
+	return r.Runner.EmitIssueWithFix(rule, message, issueRange, fixFunc)
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rulehelper
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/terraform"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+func TestModuleRunnerFix(t *testing.T) {
+	rule := &ignoreTestRule{}
+	testRunner := helper.TestRunner(t, map[string]string{"test.tf": `module "network" {
+  source = "./testdata/modules/network"
+}
+`})
+	runner, err := NewModuleRunner(&terraform.Runner{Runner: testRunner, Config: &terraform.Config{LocalModules: true}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	cases := []struct {
+		Name     string
+		Filename string
+		Fixed    bool
+	}{
+		{Name: "root", Filename: "test.tf", Fixed: true},
+		{Name: "local_module", Filename: filepath.Join("testdata", "modules", "network", "main.tf"), Fixed: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			fixed := false
+			rng := hcl.Range{Filename: tc.Filename, Start: hcl.Pos{Line: 1, Column: 1}, End: hcl.Pos{Line: 1, Column: 1}}
+			err := runner.EmitIssueWithFix(rule, "fix me", rng, func(tflint.Fixer) error {
+				fixed = true
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if fixed != tc.Fixed {
+				t.Errorf("fixed = %t, want %t", fixed, tc.Fixed)
+			}
+			if got := testRunner.Issues[len(testRunner.Issues)-1].Range.Filename; got != tc.Filename {
+				t.Errorf("issue in %s, want %s", got, tc.Filename)
+			}
+		})
+	}
+}
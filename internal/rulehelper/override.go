@@ -0,0 +1,225 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rulehelper
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// Override is the config of an override block, i.e. the rule config with the
+// block's settings applied on top, and the file globs it applies to.
+type Override[C any] struct {
+	Files  []string
+	Config C
+}
+
+// Overrides are the override blocks of a rule, in the order they are declared.
+type Overrides[C any] []Override[C]
+
+// Match returns the config of the last override whose globs match filename,
+// and whether one matched.
+func (o Overrides[C]) Match(filename string) (C, bool) {
+	for i := len(o) - 1; i >= 0; i-- {
+		for _, pattern := range o[i].Files {
+			if MatchGlob(pattern, filename) {
+				return o[i].Config, true
+			}
+		}
+	}
+
+	var config C
+	return config, false
+}
+
+// FileConfigurer is implemented by rules whose config can be overridden for
+// some files.
+type FileConfigurer[T any] interface {
+	// ForFile returns the rule with the config that applies to filename.
+	ForFile(filename string) T
+}
+
+// ForFile returns rule configured for filename and whether it is enabled for
+// that file. A rule that doesn't implement FileConfigurer is returned as-is.
+func ForFile[T any](rule T, filename string) (T, bool) {
+	if configurer, ok := any(rule).(FileConfigurer[T]); ok {
+		rule = configurer.ForFile(filename)
+	}
+	if r, ok := any(rule).(tflint.Rule); ok && !r.Enabled() {
+		return rule, false
+	}
+	return rule, true
+}
+
+// MatchGlob reports whether filename matches pattern. Patterns use path.Match
+// syntax per path segment, plus "**" which matches any number of segments, so
+// "modules/legacy/**" matches every file below modules/legacy.
+func MatchGlob(pattern string, filename string) bool {
+	name := strings.TrimPrefix(filepath.ToSlash(filename), "./")
+	pattern = strings.TrimPrefix(pattern, "./")
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+// validGlob reports whether every segment of pattern is well formed.
+func validGlob(pattern string) bool {
+	for _, segment := range strings.Split(pattern, "/") {
+		if _, err := path.Match(segment, ""); err != nil {
+			return false
+		}
+	}
+	return pattern != ""
+}
+
+// matchSegments matches the segments of a glob against those of a path.
+func matchSegments(pattern []string, name []string) bool {
+	if len(pattern) == 0 {
+		return len(name) == 0
+	}
+
+	if pattern[0] == "**" {
+		for i := 0; i <= len(name); i++ {
+			if matchSegments(pattern[1:], name[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(name) == 0 {
+		return false
+	}
+	if ok, _ := path.Match(pattern[0], name[0]); !ok {
+		return false
+	}
+	return matchSegments(pattern[1:], name[1:])
+}
+
+// decodeWithOverrides decodes the rule block into target and each of its
+// override blocks into a copy of target. The rule schema has no room for
+// override blocks, so the rule block is decoded into a struct built from the
+// fields of target plus an override block holding the same fields and files.
+// Decoding only sets what a block declares, so the block is decoded twice: the
+// first time to count the overrides, the second into copies of the rule
+// config, one per override.
+func decodeWithOverrides[C any](runner tflint.Runner, ruleName string, target *C) (Overrides[C], error) {
+	configType := reflect.TypeOf(*target)
+	fields := make([]reflect.StructField, configType.NumField())
+	for i := range fields {
+		fields[i] = configType.Field(i)
+		fields[i].Index = nil
+		fields[i].Offset = 0
+	}
+
+	overrideType := reflect.StructOf(append(append([]reflect.StructField(nil), fields...), reflect.StructField{
+		Name: "Files",
+		Type: reflect.TypeOf([]string(nil)),
+		// Optional so that a misspelt key in the block is reported ahead of
+		// the missing files.
+		Tag: `hclext:"files,optional" hcl:"files,optional"`,
+	}))
+	overrideField := len(fields)
+	wrapperType := reflect.StructOf(append(append([]reflect.StructField(nil), fields...), reflect.StructField{
+		Name: "Override",
+		Type: reflect.SliceOf(overrideType),
+		Tag:  `hclext:"override,block" hcl:"override,block"`,
+	}))
+
+	wrapper := reflect.New(wrapperType)
+	base := reflect.ValueOf(target).Elem()
+	for i := range fields {
+		wrapper.Elem().Field(i).Set(base.Field(i))
+	}
+
+	if err := runner.DecodeRuleConfig(ruleName, wrapper.Interface()); err != nil {
+		return nil, err
+	}
+
+	count := wrapper.Elem().Field(overrideField).Len()
+	if count > 0 {
+		blocks := reflect.MakeSlice(reflect.SliceOf(overrideType), count, count)
+		for i := 0; i < count; i++ {
+			for j := range fields {
+				blocks.Index(i).Field(j).Set(deepCopy(wrapper.Elem().Field(j)))
+			}
+		}
+		wrapper.Elem().Field(overrideField).Set(blocks)
+
+		if err := runner.DecodeRuleConfig(ruleName, wrapper.Interface()); err != nil {
+			return nil, err
+		}
+	}
+
+	for i := range fields {
+		base.Field(i).Set(wrapper.Elem().Field(i))
+	}
+
+	overrides := make(Overrides[C], count)
+	for i := range overrides {
+		block := wrapper.Elem().Field(overrideField).Index(i)
+		config := reflect.ValueOf(&overrides[i].Config).Elem()
+		for j := range fields {
+			config.Field(j).Set(block.Field(j))
+		}
+		overrides[i].Files = block.Field(overrideField).Interface().([]string)
+
+		if len(overrides[i].Files) == 0 {
+			return nil, fmt.Errorf("rule %q: override %d: \"files\" must list at least one pattern", ruleName, i+1)
+		}
+		for _, pattern := range overrides[i].Files {
+			if !validGlob(pattern) {
+				return nil, fmt.Errorf("rule %q: override %d: invalid files pattern %q", ruleName, i+1, pattern)
+			}
+		}
+	}
+
+	return overrides, nil
+}
+
+// deepCopy returns a copy of v that shares no pointers, slices or maps with
+// it, so that decoding into the copy leaves v alone.
+func deepCopy(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type().Elem())
+		c.Elem().Set(deepCopy(v.Elem()))
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(deepCopy(v.Index(i)))
+		}
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			c.SetMapIndex(iter.Key(), deepCopy(iter.Value()))
+		}
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if c.Field(i).CanSet() {
+				c.Field(i).Set(deepCopy(v.Field(i)))
+			}
+		}
+		return c
+	}
+	return v
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rulehelper
+
+import (
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// TerraformSchema is the schema of the top-level blocks of a Terraform
+// configuration. Decoding a body with it through the generic hcl.Body API
+// works for native and JSON syntax alike. A JSON body can't tell blocks from
+// attributes on its own, so it needs the schema to find its blocks.
+var TerraformSchema = &hcl.BodySchema{
+	Blocks: []hcl.BlockHeaderSchema{
+		{Type: "terraform"},
+		{Type: "provider", LabelNames: []string{"name"}},
+		{Type: "variable", LabelNames: []string{"name"}},
+		{Type: "locals"},
+		{Type: "output", LabelNames: []string{"name"}},
+		{Type: "module", LabelNames: []string{"name"}},
+		{Type: "resource", LabelNames: []string{"type", "name"}},
+		{Type: "data", LabelNames: []string{"type", "name"}},
+		{Type: "ephemeral", LabelNames: []string{"type", "name"}},
+		{Type: "check", LabelNames: []string{"name"}},
+		{Type: "moved"},
+		{Type: "import"},
+		{Type: "removed"},
+	},
+}
+
+// FirstMetaArguments are the meta-arguments that lead a block, and
+// LastMetaArguments those that close it, as eos_meta orders them by default.
+var (
+	FirstMetaArguments = []string{"for_each", "count"}
+	LastMetaArguments  = []string{"depends_on", "provider", "lifecycle"}
+)
+
+// IsNative reports whether file is written in native syntax. Checks that work
+// on tokens, e.g. comments and heredocs, only apply to native files: JSON has
+// neither, and lexing it as native HCL yields nonsense.
+func IsNative(file *hcl.File) bool {
+	_, ok := file.Body.(*hclsyntax.Body)
+	return ok
+}
+
+// DefRange returns the range of the type and labels of a native block. A JSON
+// block's type is a property shared by all the blocks of that type, so its
+// range is that of its last label instead, i.e. the property naming it.
+func DefRange(block *hcl.Block) hcl.Range {
+	if len(block.LabelRanges) == 0 {
+		return block.TypeRange
+	}
+
+	lastLabel := block.LabelRanges[len(block.LabelRanges)-1]
+	if _, ok := block.Body.(*hclsyntax.Body); !ok {
+		return lastLabel
+	}
+	return hcl.Range{
+		Filename: block.TypeRange.Filename,
+		Start:    block.TypeRange.Start,
+		End:      lastLabel.End,
+	}
+}
+
+// BlockRange returns the range of a whole native block, body included. The
+// JSON API doesn't expose the range of a body, so a JSON block falls back to
+// its DefRange.
+func BlockRange(block *hcl.Block) hcl.Range {
+	if body, ok := block.Body.(*hclsyntax.Body); ok {
+		return hcl.RangeBetween(block.TypeRange, body.SrcRange)
+	}
+	return DefRange(block)
+}
+
+// NativeExpression returns expr in native syntax. A JSON expression is a
+// literal, or a string holding a template whose sole interpolation stands for
+// the expression, e.g. "${var.enabled ? 1 : 0}". JSON arrays and objects have
+// no native counterpart and are reported as not ok.
+func NativeExpression(expr hcl.Expression) (hclsyntax.Expression, bool) {
+	if native, ok := expr.(hclsyntax.Expression); ok {
+		return native, true
+	}
+
+	// A nil context returns JSON strings verbatim instead of evaluating them.
+	val, diags := expr.Value(nil)
+	if diags.HasErrors() || val.IsNull() || !val.IsKnown() {
+		return nil, false
+	}
+
+	rng := expr.Range()
+	switch val.Type() {
+	case cty.String:
+		// Skip the opening quote. Escapes removed by the JSON parser aren't
+		// accounted for, so positions past one are a little off.
+		start := hcl.Pos{Line: rng.Start.Line, Column: rng.Start.Column + 1, Byte: rng.Start.Byte + 1}
+		template, diags := hclsyntax.ParseTemplate([]byte(val.AsString()), rng.Filename, start)
+		if diags.HasErrors() {
+			return nil, false
+		}
+		if wrap, ok := template.(*hclsyntax.TemplateWrapExpr); ok {
+			return wrap.Wrapped, true
+		}
+		return template, true
+	case cty.Number, cty.Bool:
+		return &hclsyntax.LiteralValueExpr{Val: val, SrcRange: rng}, true
+	}
+	return nil, false
+}
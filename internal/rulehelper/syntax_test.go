@@ -0,0 +1,116 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rulehelper
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+func TestNativeExpression(t *testing.T) {
+	src := `{
+  "resource": {
+    "aws_instance": {
+      "web": {
+        "guard": "${var.enabled ? 1 : 0}",
+        "number": 3,
+        "template": "web-${var.env}",
+        "list": ["a"]
+      }
+    }
+  }
+}`
+
+	file, diags := hclparse.NewParser().ParseJSON([]byte(src), "main.tf.json")
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+	content, _, diags := file.Body.PartialContent(TerraformSchema)
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+	if len(content.Blocks) != 1 {
+		t.Fatalf("got %d blocks, want 1", len(content.Blocks))
+	}
+	block := content.Blocks[0]
+
+	if got := DefRange(block); got.Start.Line != 4 || got.Start.Column != 7 {
+		t.Errorf("DefRange = %s, want the web property at 4,7", got)
+	}
+
+	attrs, diags := block.Body.JustAttributes()
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+
+	cases := []struct {
+		Name string
+		OK   bool
+		Want func(hclsyntax.Expression) bool
+	}{
+		{
+			Name: "guard",
+			OK:   true,
+			Want: func(e hclsyntax.Expression) bool {
+				cond, ok := e.(*hclsyntax.ConditionalExpr)
+				return ok && cond.Range().Start.Line == 5
+			},
+		},
+		{
+			Name: "number",
+			OK:   true,
+			Want: func(e hclsyntax.Expression) bool {
+				_, ok := e.(*hclsyntax.LiteralValueExpr)
+				return ok
+			},
+		},
+		{
+			Name: "template",
+			OK:   true,
+			Want: func(e hclsyntax.Expression) bool {
+				_, ok := e.(*hclsyntax.TemplateExpr)
+				return ok
+			},
+		},
+		{
+			Name: "list",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			expr, ok := NativeExpression(attrs[tc.Name].Expr)
+			if ok != tc.OK {
+				t.Fatalf("ok = %v, want %v", ok, tc.OK)
+			}
+			if ok && !tc.Want(expr) {
+				t.Errorf("unexpected expression %T", expr)
+			}
+		})
+	}
+}
+
+func TestDefRangeNative(t *testing.T) {
+	src := `resource "aws_instance" "web" {
+  ami = "ami-123"
+}
+`
+
+	file, diags := hclsyntax.ParseConfig([]byte(src), "main.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+	content, _, _ := file.Body.PartialContent(TerraformSchema)
+	block := content.Blocks[0]
+
+	if got := DefRange(block); got.Start.Column != 1 || got.End.Column != 30 {
+		t.Errorf("DefRange = %s, want 1:1-30", got)
+	}
+	if got := BlockRange(block); got.End.Line != 3 {
+		t.Errorf("BlockRange ends on line %d, want 3", got.End.Line)
+	}
+}
@@ -0,0 +1,4 @@
+variable "Subnet" {}
+
+# eos-ignore: fake.upper -- matches the provider's naming
+variable "Vpc" {}
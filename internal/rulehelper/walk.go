@@ -34,7 +34,7 @@ var AllLintableBlocks = []BlockDef{
 // two-label blocks (e.g., resource, data), the first label is the type and
 // the second is the name. For single-label blocks (e.g., variable, output),
 // the block type itself serves as the type and the label is the name.
-func normalizeBlock(block *hclsyntax.Block, myBlocks []BlockDef) (string, string, string) {
+func normalizeBlock(block *hcl.Block, myBlocks []BlockDef) (string, string, string) {
 	var name string
 	var typ string
 
@@ -60,87 +60,129 @@ func normalizeBlock(block *hclsyntax.Block, myBlocks []BlockDef) (string, string
 	return typ, name, synonym
 }
 
-// WalkBlocks iterates over blocks and locals using AST and applies the check
-// function.
+// WalkBlocks iterates over blocks and locals and applies the check function.
+// Blocks are decoded through the generic hcl.Body API, so JSON files are
+// walked as well as native ones, and shared with other rules through the
+// SourceFile cache. Each file is checked with the rule configured for it, and
+// files the rule is disabled for are skipped.
 func WalkBlocks[T any](
 	runner tflint.Runner,
 	myBlocks []BlockDef,
 	rule T,
 	checkFuncs ...func(tflint.Runner, T, hcl.Range, string, string, string),
 ) error {
+	return eachBlock(runner, rule, func(rule T, block *hcl.Block) {
+		// Handle locals specifically.
+		if block.Type == "locals" {
+			attrs, _ := block.Body.JustAttributes()
+			for _, attr := range attrs {
+				for _, checkFunc := range checkFuncs {
+					checkFunc(runner, rule, attr.Range, "local", attr.Name, "")
+				}
+			}
+			return
+		}
+
+		// Filter by myBlocks to ensure we only lint what we expect.
+		if !isMyBlock(block, myBlocks) {
+			return
+		}
+
+		typ, name, synonym := normalizeBlock(block, myBlocks)
+
+		// For style linting the header is what we care about, so issues are
+		// reported at the range of the type and labels.
+		for _, checkFunc := range checkFuncs {
+			checkFunc(runner, rule, DefRange(block), typ, name, synonym)
+		}
+	})
+}
+
+// WalkBlockContents iterates over the top-level blocks in myBlocks and applies
+// the check functions to each, for checks that look inside a block rather than
+// at its name. As with WalkBlocks, JSON files are walked too, and each file is
+// checked with the rule configured for it.
+func WalkBlockContents[T any](
+	runner tflint.Runner,
+	myBlocks []BlockDef,
+	rule T,
+	checkFuncs ...func(tflint.Runner, T, *hcl.Block),
+) error {
+	return eachBlock(runner, rule, func(rule T, block *hcl.Block) {
+		if !isMyBlock(block, myBlocks) {
+			return
+		}
+		for _, checkFunc := range checkFuncs {
+			checkFunc(runner, rule, block)
+		}
+	})
+}
+
+// eachBlock calls fn with every top-level block of the files the rule is
+// enabled for, and the rule configured for the file.
+func eachBlock[T any](runner tflint.Runner, rule T, fn func(T, *hcl.Block)) error {
 	files, err := runner.GetFiles()
 	if err != nil {
 		return err
 	}
 
-	for _, file := range files {
-		body, ok := file.Body.(*hclsyntax.Body)
-		if !ok {
+	for filename, file := range files {
+		rule, enabled := ForFile(rule, filename)
+		if !enabled {
 			continue
 		}
 
-		for _, block := range body.Blocks {
-			// Handle locals specifically.
-			if block.Type == "locals" {
-				for _, attr := range block.Body.Attributes {
-					for _, checkFunc := range checkFuncs {
-						checkFunc(runner, rule, attr.Range(), "local", attr.Name, "")
-					}
-				}
-				continue
-			}
-
-			// Handle other blocks.
-			typ, name, synonym := normalizeBlock(block, myBlocks)
-
-			// Filter by myBlocks to ensure we only lint what we expect.
-			found := false
-			for _, def := range myBlocks {
-				if def.Typ == block.Type {
-					found = true
-					break
-				}
-			}
-			if !found {
-				continue
-			}
-
-			// Calculate a reasonable range for the definition. Ideally we want
-			// the range of the type and labels. hclsyntax.Block doesn't have a
-			// single DefRange, but we can construct one or just use the block's
-			// range (which includes the body). For style linting, usually the
-			// header is what we care about. Here we construct it from TypeRange
-			// and LabelRanges.
-			defRange := block.TypeRange
-			if len(block.LabelRanges) > 0 {
-				lastLabel := block.LabelRanges[len(block.LabelRanges)-1]
-				defRange = hcl.Range{
-					Filename: defRange.Filename,
-					Start:    defRange.Start,
-					End:      lastLabel.End,
-				}
-			}
-
-			for _, checkFunc := range checkFuncs {
-				checkFunc(runner, rule, defRange, typ, name, synonym)
-			}
+		for _, block := range Source(filename, file).Blocks() {
+			fn(rule, block)
 		}
 	}
 
 	return nil
 }
 
-// WalkTokens iterates over all files in the root module, lexes them, and
-// applies the check function to each token.
+// isMyBlock reports whether block is of a type listed in myBlocks.
+func isMyBlock(block *hcl.Block, myBlocks []BlockDef) bool {
+	for _, def := range myBlocks {
+		if def.Typ == block.Type {
+			return true
+		}
+	}
+	return false
+}
+
+// WalkTokens iterates over all native files in the root module and applies the
+// check function to each token. Tokens come from the SourceFile cache, so a
+// file is lexed once however many rules walk it. JSON files have no tokens
+// worth checking and are skipped. As with WalkBlocks, each file is checked
+// with the rule configured for it.
 func WalkTokens[T any](
 	runner tflint.Runner,
 	rule T,
 	checkFunc func(tflint.Runner, T, hclsyntax.Token),
+) error {
+	return WalkFileTokens(runner, rule, func(runner tflint.Runner, rule T, _ *SourceFile, tokens hclsyntax.Tokens) {
+		for _, token := range tokens {
+			checkFunc(runner, rule, token)
+		}
+	})
+}
+
+// WalkFileTokens is WalkTokens for checks that look at the tokens of a file
+// together, e.g. at the lines between them, rather than one at a time. The
+// check function gets the file along with its tokens, so it can match them up
+// with the syntax tree.
+func WalkFileTokens[T any](
+	runner tflint.Runner,
+	rule T,
+	checkFunc func(tflint.Runner, T, *SourceFile, hclsyntax.Tokens),
 ) error {
 	path, err := runner.GetModulePath()
 	if err != nil {
 		return err
 	}
+	// TFLint drops style issues reported from a called module's runner. Local
+	// modules are linted from the root instead, through the files a
+	// ModuleRunner adds when local_modules is set.
 	if !path.IsRoot() {
 		return nil
 	}
@@ -151,14 +193,22 @@ func WalkTokens[T any](
 	}
 
 	for filename, file := range files {
-		tokens, diags := hclsyntax.LexConfig(file.Bytes, filename, hcl.InitialPos)
+		if !IsNative(file) {
+			continue
+		}
+
+		rule, enabled := ForFile(rule, filename)
+		if !enabled {
+			continue
+		}
+
+		source := Source(filename, file)
+		tokens, diags := source.Tokens()
 		if diags.HasErrors() {
 			return diags
 		}
 
-		for _, token := range tokens {
-			checkFunc(runner, rule, token)
-		}
+		checkFunc(runner, rule, source, tokens)
 	}
 
 	return nil
@@ -0,0 +1,43 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rulehelper
+
+import (
+	"unicode"
+
+	"golang.org/x/text/width"
+)
+
+// DefaultTabWidth is the number of columns between tab stops when a rule
+// doesn't configure it.
+const DefaultTabWidth = 4
+
+// DisplayWidth returns the number of columns text takes up in an editor or
+// terminal. A tab advances to the next multiple of tabWidth, wide characters,
+// e.g. CJK ones, take two columns, and combining marks and other zero-width
+// characters none. HCL counts columns in characters, and len counts bytes, so
+// neither matches what a reader sees.
+func DisplayWidth(text string, tabWidth int) int {
+	if tabWidth < 1 {
+		tabWidth = DefaultTabWidth
+	}
+
+	columns := 0
+	for _, r := range text {
+		switch {
+		case r == '\t':
+			columns += tabWidth - columns%tabWidth
+		case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+			// Zero width.
+		default:
+			switch width.LookupRune(r).Kind() {
+			case width.EastAsianWide, width.EastAsianFullwidth:
+				columns += 2
+			default:
+				columns++
+			}
+		}
+	}
+	return columns
+}
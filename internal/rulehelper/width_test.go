@@ -0,0 +1,35 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rulehelper
+
+import "testing"
+
+func TestDisplayWidth(t *testing.T) {
+	cases := []struct {
+		name string
+		text string
+		tab  int
+		want int
+	}{
+		{"ascii", "resource", 4, 8},
+		{"empty", "", 4, 0},
+		{"leading tab", "\tx", 4, 5},
+		{"tab stop", "ab\tc", 4, 5},
+		{"tab width", "ab\tc", 8, 9},
+		{"default tab width", "\t", 0, DefaultTabWidth},
+		{"multi-byte", "caf\u00e9", 4, 4},
+		{"combining mark", "cafe\u0301", 4, 4},
+		{"zero width space", "a\u200bb", 4, 2},
+		{"wide", "\u65e5\u672c", 4, 4},
+		{"fullwidth", "\uff21\uff22", 4, 4},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := DisplayWidth(tc.text, tc.tab); got != tc.want {
+				t.Errorf("DisplayWidth(%q, %d) = %d, want %d", tc.text, tc.tab, got, tc.want)
+			}
+		})
+	}
+}
@@ -10,4 +10,16 @@ package terraform
 // Config is the configuration for the ruleset.
 type Config struct {
 	Preset string `hclext:"preset,optional"`
+	// RequireIgnoreReason makes eos-ignore annotations without a "-- reason"
+	// suppress nothing and be reported instead.
+	RequireIgnoreReason bool `hclext:"require_ignore_reason,optional"`
+	// ReportUnusedIgnores reports eos-ignore annotations that no longer match
+	// any issue.
+	ReportUnusedIgnores bool `hclext:"report_unused_ignores,optional"`
+	// Baseline is the file of accepted issues, which are suppressed. Defaults
+	// to .eos-baseline.json.
+	Baseline string `hclext:"baseline,optional"`
+	// LocalModules lints the files of modules called with a local source
+	// along with those of the root module.
+	LocalModules bool `hclext:"local_modules,optional"`
 }
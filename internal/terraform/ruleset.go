@@ -111,10 +111,35 @@ func (r *RuleSet) ApplyConfig(body *hclext.BodyContent) error {
 		}
 	}
 
+	if last := len(r.EnabledRules) - 1; last >= 0 {
+		r.EnabledRules[last] = &finishingRule{Rule: r.EnabledRules[last]}
+	}
+
+	return nil
+}
+
+// finishingRule wraps the last enabled rule to finish the runner once the rule
+// has checked the module. TFLint checks the enabled rules in order with the
+// same runner and stops at the first that fails, so the runner is finished
+// only once every rule has succeeded.
+type finishingRule struct {
+	tflint.Rule
+}
+
+// Check checks the module with the wrapped rule, then finishes the runner.
+func (r *finishingRule) Check(runner tflint.Runner) error {
+	if err := r.Rule.Check(runner); err != nil {
+		return err
+	}
+	if custom, ok := runner.(*Runner); ok {
+		return custom.Finish()
+	}
 	return nil
 }
 
 // NewRunner injects a custom runner
 func (r *RuleSet) NewRunner(runner tflint.Runner) (tflint.Runner, error) {
-	return NewRunner(runner), nil
+	custom := NewRunner(runner)
+	custom.Config = r.rulesetConfig
+	return custom, nil
 }
@@ -8,6 +8,7 @@
 package terraform
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -279,3 +280,65 @@ func TestApplyConfig(t *testing.T) {
 		})
 	}
 }
+
+// finishTestRule registers a finisher counting its calls, then fails with err.
+type finishTestRule struct {
+	testRule
+	finished *int
+	err      error
+}
+
+func (r *finishTestRule) Check(runner tflint.Runner) error {
+	runner.(*Runner).OnFinish("count", func() error {
+		*r.finished++
+		return nil
+	})
+	return r.err
+}
+
+func TestFinish(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{name: "succeeded", want: 1},
+		{name: "failed", err: errors.New("failed"), want: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			finished := 0
+			ruleset := &RuleSet{
+				PresetRules: map[string][]tflint.Rule{
+					"all": {
+						&finishTestRule{testRule: testRule{name: "eos_first"}, finished: &finished},
+						&finishTestRule{testRule: testRule{name: "eos_last"}, finished: &finished, err: test.err},
+					},
+				},
+			}
+			ruleset.ConfigSchema()
+			if err := ruleset.ApplyGlobalConfig(&tflint.Config{}); err != nil {
+				t.Fatal(err)
+			}
+			if err := ruleset.ApplyConfig(&hclext.BodyContent{}); err != nil {
+				t.Fatal(err)
+			}
+
+			// TFLint checks the enabled rules in order with one runner.
+			runner, err := ruleset.NewRunner(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, rule := range ruleset.EnabledRules {
+				if err := rule.Check(runner); err != nil {
+					break
+				}
+			}
+
+			if finished != test.want {
+				t.Errorf("finished %d times, want %d", finished, test.want)
+			}
+		})
+	}
+}
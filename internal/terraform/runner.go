@@ -8,12 +8,18 @@
 package terraform
 
 import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/hashicorp/hcl/v2/json"
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 	"github.com/zclconf/go-cty/cty"
 )
@@ -21,6 +27,13 @@ import (
 // Runner is a custom runner that provides helper functions for this ruleset.
 type Runner struct {
 	tflint.Runner
+
+	// Config is the plugin block config, if the runner was made by a RuleSet.
+	Config *Config
+
+	// finishers are run by Finish, in the order their keys were registered.
+	finishers  map[string]func() error
+	finishKeys []string
 }
 
 // NewRunner returns a new custom runner.
@@ -28,25 +41,54 @@ func NewRunner(runner tflint.Runner) *Runner {
 	return &Runner{Runner: runner}
 }
 
+// OnFinish registers fn to run once every rule has checked the module, e.g. to
+// write out what the rules collected over the check. Only the first fn
+// registered under a key runs, so every rule can register the same work.
+func (r *Runner) OnFinish(key string, fn func() error) {
+	if r.finishers == nil {
+		r.finishers = map[string]func() error{}
+	}
+	if _, ok := r.finishers[key]; ok {
+		return
+	}
+	r.finishers[key] = fn
+	r.finishKeys = append(r.finishKeys, key)
+}
+
+// Finish runs the functions registered with OnFinish, then forgets them.
+func (r *Runner) Finish() error {
+	keys, finishers := r.finishKeys, r.finishers
+	r.finishKeys, r.finishers = nil, nil
+	for _, key := range keys {
+		if err := finishers[key](); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// moduleCallSchema is the schema of "module" blocks decoded as module calls.
+var moduleCallSchema = &hclext.BodySchema{
+	Blocks: []hclext.BlockSchema{
+		{
+			Type:       "module",
+			LabelNames: []string{"name"},
+			Body: &hclext.BodySchema{
+				Attributes: []hclext.AttributeSchema{
+					{Name: "source"},
+					{Name: "version"},
+				},
+			},
+		},
+	},
+}
+
 // GetModuleCalls returns all "module" blocks, including uncreated module calls.
 func (r *Runner) GetModuleCalls() ([]*ModuleCall, hcl.Diagnostics) {
 	calls := []*ModuleCall{}
 	diags := hcl.Diagnostics{}
 
-	body, err := r.GetModuleContent(&hclext.BodySchema{
-		Blocks: []hclext.BlockSchema{
-			{
-				Type:       "module",
-				LabelNames: []string{"name"},
-				Body: &hclext.BodySchema{
-					Attributes: []hclext.AttributeSchema{
-						{Name: "source"},
-						{Name: "version"},
-					},
-				},
-			},
-		},
-	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	body, err := r.GetModuleContent(moduleCallSchema, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
 	if err != nil {
 		return calls, hcl.Diagnostics{
 			{
@@ -69,6 +111,116 @@ func (r *Runner) GetModuleCalls() ([]*ModuleCall, hcl.Diagnostics) {
 	return calls, diags
 }
 
+// LocalModuleFiles returns the files of the modules the current module calls
+// with a local source, such as "./modules/vpc", keyed by their path as TFLint
+// names files, i.e. from the directory it was started in. A source is resolved
+// against the directory of the file calling it, as Terraform does. The local
+// modules those modules call are included in turn, and each directory is read
+// once. A source directory that doesn't exist is skipped.
+//
+// TFLint doesn't load these files, so they are read from disk here, and only
+// the .tf and .tf.json files directly in each directory are read.
+func (r *Runner) LocalModuleFiles() (map[string]*hcl.File, error) {
+	calls, diags := r.GetModuleCalls()
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	wd, err := r.GetOriginalwd()
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string]*hcl.File{}
+	seen := map[string]bool{}
+	for _, call := range calls {
+		seen[filepath.Dir(call.DefRange.Filename)] = true
+	}
+
+	for len(calls) > 0 {
+		call := calls[0]
+		calls = calls[1:]
+		if !IsLocalSource(call.Source) {
+			continue
+		}
+
+		dir := filepath.Join(filepath.Dir(call.DefRange.Filename), call.Source)
+		if seen[dir] {
+			continue
+		}
+		seen[dir] = true
+
+		moduleFiles, err := loadModuleFiles(wd, dir)
+		if err != nil {
+			return nil, err
+		}
+		for filename, file := range moduleFiles {
+			files[filename] = file
+
+			content, contentDiags := hclext.PartialContent(file.Body, moduleCallSchema)
+			if contentDiags.HasErrors() {
+				return nil, contentDiags
+			}
+			for _, block := range content.Blocks {
+				if nested, callDiags := decodeModuleCall(block); !callDiags.HasErrors() {
+					calls = append(calls, nested)
+				}
+			}
+		}
+	}
+
+	return files, nil
+}
+
+// IsLocalSource reports whether a module source is a path on disk rather than
+// a registry, VCS or archive address.
+func IsLocalSource(source string) bool {
+	return strings.HasPrefix(source, "./") || strings.HasPrefix(source, "../")
+}
+
+// loadModuleFiles parses the .tf and .tf.json files in dir, keyed by path. A
+// relative dir is read from wd.
+func loadModuleFiles(wd string, dir string) (map[string]*hcl.File, error) {
+	path := dir
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(wd, path)
+	}
+	entries, err := os.ReadDir(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		logger.Debug(fmt.Sprintf("local module %s not found", dir))
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string]*hcl.File{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !(strings.HasSuffix(name, ".tf") || strings.HasSuffix(name, ".tf.json")) {
+			continue
+		}
+
+		filename := filepath.Join(dir, name)
+		src, err := os.ReadFile(filepath.Join(path, name))
+		if err != nil {
+			return nil, err
+		}
+
+		var file *hcl.File
+		var diags hcl.Diagnostics
+		if strings.HasSuffix(name, ".tf") {
+			file, diags = hclsyntax.ParseConfig(src, filename, hcl.InitialPos)
+		} else {
+			file, diags = json.Parse(src, filename)
+		}
+		if diags.HasErrors() {
+			return nil, diags
+		}
+		files[filename] = file
+	}
+	return files, nil
+}
+
 // GetLocals returns all entries in "locals" blocks.
 func (r *Runner) GetLocals() (map[string]*Local, hcl.Diagnostics) {
 	locals := map[string]*Local{}
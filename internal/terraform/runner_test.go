@@ -8,6 +8,9 @@
 package terraform
 
 import (
+	"os"
+	"path/filepath"
+	"sort"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -139,6 +142,61 @@ module "vpc" {
 	}
 }
 
+// originalwdRunner is a test runner started in another directory than the
+// one the test runs in, as with TFLint's --chdir.
+type originalwdRunner struct {
+	*helper.Runner
+	wd string
+}
+
+func (r *originalwdRunner) GetOriginalwd() (string, error) {
+	return r.wd, nil
+}
+
+func TestLocalModuleFiles(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Module files are read from where TFLint was started, not from the
+	// working directory of the plugin.
+	t.Chdir(t.TempDir())
+
+	runner := NewRunner(&originalwdRunner{wd: wd, Runner: helper.TestRunner(t, map[string]string{"main.tf": `
+module "child" {
+  source = "./testdata/modules/child"
+}
+
+module "missing" {
+  source = "./testdata/modules/missing"
+}
+
+module "remote" {
+  source = "git::https://example.com/vpc.git"
+}
+`})})
+
+	files, err := runner.LocalModuleFiles()
+	if err != nil {
+		t.Fatalf("failed to call LocalModuleFiles: %s", err)
+	}
+
+	var got []string
+	for filename := range files {
+		got = append(got, filepath.ToSlash(filename))
+	}
+	sort.Strings(got)
+
+	want := []string{
+		"testdata/modules/child/main.tf",
+		"testdata/modules/grandchild/main.tf",
+		"testdata/modules/grandchild/outputs.tf.json",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error(diff)
+	}
+}
+
 func TestGetLocals(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -0,0 +1,8 @@
+module "grandchild" {
+  source = "../grandchild"
+}
+
+module "remote" {
+  source  = "hashicorp/consul/aws"
+  version = "0.1.0"
+}
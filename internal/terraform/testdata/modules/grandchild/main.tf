@@ -0,0 +1,4 @@
+# Calls back into its caller, which must not be read twice.
+module "child" {
+  source = "../child"
+}
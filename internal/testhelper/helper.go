@@ -6,15 +6,16 @@ package testhelper
 
 import (
 	"encoding/json"
+	"os"
 	"reflect"
 	"sort"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/hashicorp/hcl/v2/hclparse"
 	"github.com/terraform-linters/tflint-plugin-sdk/helper"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
-
-	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
 )
 
 // TestConfigFile is the standard path to the test config file relative to each
@@ -29,6 +30,13 @@ type ConfigTestCase struct {
 	Want any
 }
 
+// ConfigErrorTestCase represents a test case for a rule block that must fail
+// validation. Want is the error Check is expected to return.
+type ConfigErrorTestCase struct {
+	Name string
+	Want string
+}
+
 // IssueSummary represents a summary of an issue's message and line number.
 type IssueSummary struct {
 	Message string
@@ -45,6 +53,32 @@ type RuleTestCase struct {
 	Want    []string
 }
 
+// FilesTestCase represents a rule test case over several source files, for
+// config that differs from file to file. Files maps each filename, which may
+// include directories, to its content.
+type FilesTestCase struct {
+	Name  string
+	Files map[string]string
+	Want  []string
+}
+
+// FixTestCase represents a test case for a rule's autofix. Want is the
+// complete source expected after the fixes are applied. A Want equal to
+// Content asserts that the rule made no changes.
+type FixTestCase struct {
+	Name    string
+	Content string
+	Want    string
+}
+
+// SeverityTestCase represents a test case for sub-check levels. Want maps each
+// expected issue message to the severity it must be reported at.
+type SeverityTestCase struct {
+	Name    string
+	Content string
+	Want    map[string]tflint.Severity
+}
+
 // assertRuleIssueMessages tests that the issues collected by the rule test
 // match in both length and values.
 func assertRuleIssueMessages(t *testing.T, expected []string, issues []*helper.Issue) {
@@ -81,7 +115,9 @@ func assertRuleIssueMessages(t *testing.T, expected []string, issues []*helper.I
 	}
 }
 
-// ConfigTestRunner runs each of the config test cases.
+// ConfigTestRunner runs each of the config test cases. The rule block is
+// decoded the way TFLint hands it to the plugin, so its enabled attribute never
+// reaches the rule config.
 func ConfigTestRunner[D any](t *testing.T, defaultConfig D, cases []ConfigTestCase) {
 	for _, tc := range cases {
 		t.Run(tc.Name, func(t *testing.T) {
@@ -95,8 +131,9 @@ func ConfigTestRunner[D any](t *testing.T, defaultConfig D, cases []ConfigTestCa
 				t.Fatalf("DeepCopy failed: %v", err)
 			}
 
-			if err := rulehelper.LoadRuleConfig(tc.Name, &got, TestConfigFile); err != nil {
-				t.Fatalf("LoadRuleConfig failed: %v", err)
+			runner := testRunner(t, TestConfigFile, nil)
+			if err := runner.DecodeRuleConfig(tc.Name, &got); err != nil {
+				t.Fatalf("DecodeRuleConfig failed: %v", err)
 			}
 
 			if diff := cmp.Diff(tc.Want, got); diff != "" {
@@ -106,6 +143,32 @@ func ConfigTestRunner[D any](t *testing.T, defaultConfig D, cases []ConfigTestCa
 	}
 }
 
+// ConfigErrorTestRunner runs each of the config error test cases. The rule
+// block named after the case is decoded by Check, which must fail with exactly
+// the wanted error before any issue is emitted.
+func ConfigErrorTestRunner(t *testing.T, ruleFactory func() tflint.Rule, cases []ConfigErrorTestCase) {
+	for _, cv := range cases {
+		c := cv
+		t.Run(c.Name, func(t *testing.T) {
+			rule := ruleFactory()
+			configureRule(rule, c.Name)
+
+			runner := testRunner(t, TestConfigFile, nil)
+
+			err := rule.Check(runner)
+			if err == nil {
+				t.Fatalf("Expected error %q, got none", c.Want)
+			}
+			if diff := cmp.Diff(c.Want, err.Error()); diff != "" {
+				t.Errorf("error mismatch (-want +got):\n%s", diff)
+			}
+			if len(runner.Issues) != 0 {
+				t.Errorf("Expected no issues, got %d", len(runner.Issues))
+			}
+		})
+	}
+}
+
 // deepCopy performs a deep copy of a generic type T using JSON. This is needed
 // in the tests so that the parsed config (the got) doesn't clobber the want.
 func deepCopy[T any](src T) (T, error) {
@@ -152,31 +215,165 @@ func RuleTestRunner(t *testing.T, ruleFactory func() tflint.Rule, configFile str
 		c := cv
 		t.Run(c.Name, func(t *testing.T) {
 			rule := ruleFactory()
+			configureRule(rule, c.Name)
+
+			runner := testRunner(t, configFile, map[string]string{sourceFilename: c.Content})
 
-			// Use reflection to set RuleName and ConfigFile on the rule struct.
-			// This tells Check() which config to load and from where.
-			rVal := reflect.ValueOf(rule)
-			if rVal.Kind() == reflect.Ptr {
-				rVal = rVal.Elem()
+			if ruleEnabled(t, rule, configFile) {
+				if err := rule.Check(runner); err != nil {
+					t.Fatalf("Unexpected error occurred: %s", err)
+				}
 			}
 
-			ruleNameField := rVal.FieldByName("RuleName")
-			if ruleNameField.IsValid() && ruleNameField.CanSet() {
-				ruleNameField.SetString(c.Name)
+			assertRuleIssueMessages(t, c.Want, runner.Issues)
+		})
+	}
+}
+
+// FilesTestRunner runs each of the multi-file test cases. As with
+// RuleTestRunner, the issues of all files are asserted collectively.
+func FilesTestRunner(t *testing.T, ruleFactory func() tflint.Rule, configFile string, cases []FilesTestCase) {
+	for _, cv := range cases {
+		c := cv
+		t.Run(c.Name, func(t *testing.T) {
+			rule := ruleFactory()
+			configureRule(rule, c.Name)
+
+			runner := testRunner(t, configFile, c.Files)
+
+			if ruleEnabled(t, rule, configFile) {
+				if err := rule.Check(runner); err != nil {
+					t.Fatalf("Unexpected error occurred: %s", err)
+				}
 			}
 
-			configFileField := rVal.FieldByName("ConfigFile")
-			if configFileField.IsValid() && configFileField.CanSet() {
-				configFileField.SetString(configFile)
+			assertRuleIssueMessages(t, c.Want, runner.Issues)
+		})
+	}
+}
+
+// FixTestRunner runs each of the autofix test cases. The fixed source is
+// compared as a whole since a fix is only correct if it leaves the rest of the
+// file alone.
+func FixTestRunner(t *testing.T, ruleFactory func() tflint.Rule, configFile string, cases []FixTestCase, sourceFilename string) {
+	for _, cv := range cases {
+		c := cv
+		t.Run(c.Name, func(t *testing.T) {
+			rule := ruleFactory()
+			configureRule(rule, c.Name)
+
+			runner := testRunner(t, configFile, map[string]string{sourceFilename: c.Content})
+
+			if ruleEnabled(t, rule, configFile) {
+				if err := rule.Check(runner); err != nil {
+					t.Fatalf("Unexpected error occurred: %s", err)
+				}
 			}
 
-			runner := helper.TestRunner(t, map[string]string{sourceFilename: c.Content})
+			got := c.Content
+			if changed, ok := runner.Changes()[sourceFilename]; ok {
+				got = string(changed)
+			}
 
-			if err := rule.Check(runner); err != nil {
-				t.Fatalf("Unexpected error occurred: %s", err)
+			if diff := cmp.Diff(c.Want, got); diff != "" {
+				t.Errorf("fixed source mismatch (-want +got):\n%s", diff)
 			}
+		})
+	}
+}
 
-			assertRuleIssueMessages(t, c.Want, runner.Issues)
+// SeverityTestRunner runs each of the severity test cases. Every issue must
+// carry the severity wanted for its message, and every wanted message must be
+// reported.
+func SeverityTestRunner(t *testing.T, ruleFactory func() tflint.Rule, configFile string, cases []SeverityTestCase, sourceFilename string) {
+	for _, cv := range cases {
+		c := cv
+		t.Run(c.Name, func(t *testing.T) {
+			rule := ruleFactory()
+			configureRule(rule, c.Name)
+
+			runner := testRunner(t, configFile, map[string]string{sourceFilename: c.Content})
+
+			if ruleEnabled(t, rule, configFile) {
+				if err := rule.Check(runner); err != nil {
+					t.Fatalf("Unexpected error occurred: %s", err)
+				}
+			}
+
+			seen := map[string]bool{}
+			for _, issue := range runner.Issues {
+				want, ok := c.Want[issue.Message]
+				if !ok {
+					t.Errorf("Unexpected issue at line %d: %s", issue.Range.Start.Line, issue.Message)
+					continue
+				}
+				if got := issue.Rule.Severity(); got != want {
+					t.Errorf("Severity mismatch for %q: got %s, want %s", issue.Message, got, want)
+				}
+				seen[issue.Message] = true
+			}
+			for message := range c.Want {
+				if !seen[message] {
+					t.Errorf("Missing issue: %s", message)
+				}
+			}
 		})
 	}
 }
+
+// configureRule uses reflection to set RuleName on the rule struct. This tells
+// Check() which rule block to decode from the config.
+func configureRule(rule tflint.Rule, ruleName string) {
+	rVal := reflect.ValueOf(rule)
+	if rVal.Kind() == reflect.Ptr {
+		rVal = rVal.Elem()
+	}
+
+	ruleNameField := rVal.FieldByName("RuleName")
+	if ruleNameField.IsValid() && ruleNameField.CanSet() {
+		ruleNameField.SetString(ruleName)
+	}
+}
+
+// testRunner returns a TFLint test runner over files that has loaded
+// configFile as its .tflint.hcl, so that rules decode their config through
+// runner.DecodeRuleConfig as they do under TFLint. TFLINT_CONFIG_FILE points
+// at configFile too, as rules read it to explain a config TFLint rejects.
+func testRunner(t *testing.T, configFile string, files map[string]string) *helper.Runner {
+	t.Helper()
+	t.Setenv("TFLINT_CONFIG_FILE", configFile)
+
+	config, err := os.ReadFile(configFile)
+	if err != nil {
+		t.Fatalf("Failed to read config file %s: %s", configFile, err)
+	}
+
+	all := map[string]string{".tflint.hcl": string(config)}
+	for name, content := range files {
+		all[name] = content
+	}
+	return helper.TestRunner(t, all)
+}
+
+// ruleEnabled reports whether TFLint would run the rule. As with TFLint, the
+// enabled attribute of the rule block wins, otherwise the rule's default
+// applies.
+func ruleEnabled(t *testing.T, rule tflint.Rule, configFile string) bool {
+	t.Helper()
+
+	file, diags := hclparse.NewParser().ParseHCLFile(configFile)
+	if diags.HasErrors() {
+		t.Fatalf("Failed to parse config file %s: %s", configFile, diags)
+	}
+	var config helper.Config
+	if diags := gohcl.DecodeBody(file.Body, nil, &config); diags.HasErrors() {
+		t.Fatalf("Failed to decode config file %s: %s", configFile, diags)
+	}
+
+	for _, ruleConfig := range config.Rules {
+		if ruleConfig.Name == rule.Name() {
+			return ruleConfig.Enabled
+		}
+	}
+	return rule.Enabled()
+}
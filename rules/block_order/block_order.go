@@ -0,0 +1,190 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package blockorder
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// Messages emitted by the checks.
+const (
+	MisOrderedMessage = "%s blocks should come before %s blocks."
+	UnsortedMessage   = "%s '%s' should come before '%s'."
+)
+
+// defaultOrder is the default order of the top-level block types. Types not
+// listed may appear anywhere.
+var defaultOrder = []string{"terraform", "provider", "locals", "data", "resource", "module", "output"}
+
+// sortedTypes are the block types the alphabetical check orders by name.
+var sortedTypes = []string{"output", "variable"}
+
+// blockOrderConfig represents the configuration for the BlockOrderRule.
+type blockOrderConfig struct {
+	Alphabetical rulehelper.SubCheck `hclext:"alphabetical,optional" hcl:"alphabetical,optional"`
+	Enabled      *bool               `hclext:"enabled,optional" hcl:"enabled,optional"`
+	Level        string              `hclext:"level,optional" hcl:"level,optional"`
+	// Order is the order of the top-level block types. An empty list turns
+	// the order check off.
+	Order []string `hclext:"order,optional" hcl:"order,optional"`
+}
+
+// Validate checks the values of the config.
+func (c *blockOrderConfig) Validate() error {
+	var types []string
+	for _, block := range rulehelper.TerraformSchema.Blocks {
+		types = append(types, block.Type)
+	}
+	for i, typ := range c.Order {
+		if !slices.Contains(types, typ) {
+			return fmt.Errorf("\"order\" must list block types, one of %s, not %q", strings.Join(types, ", "), typ)
+		}
+		if slices.Contains(c.Order[:i], typ) {
+			return fmt.Errorf("\"order\" lists %q more than once", typ)
+		}
+	}
+	return nil
+}
+
+// defaultBlockOrderConfig is the default configuration for the
+// BlockOrderRule.
+var defaultBlockOrderConfig = blockOrderConfig{
+	Alphabetical: "false",
+	Enabled:      rulehelper.BoolPtr(true),
+	Level:        "warning",
+	Order:        defaultOrder,
+}
+
+// Rule checks the order of the top-level blocks in a file.
+type Rule struct {
+	tflint.DefaultRule
+	Config blockOrderConfig
+	// RuleName is the rule block name to load from the config file. If empty,
+	// defaults to "eos_block_order".
+	RuleName string
+	// overrides are the override blocks of the rule block, applied by ForFile.
+	overrides rulehelper.Overrides[blockOrderConfig]
+}
+
+// Check checks whether the rule conditions are met.
+func (r *Rule) Check(runner tflint.Runner) error {
+	ignores, overrides, err := rulehelper.BeginCheck(runner, r, &r.Config)
+	if err != nil || ignores == nil {
+		return err
+	}
+	r.overrides = overrides
+
+	files, err := ignores.GetFiles()
+	if err != nil {
+		return err
+	}
+
+	for filename, file := range files {
+		// Decoding JSON doesn't keep the order of its blocks, so only native
+		// files are checked.
+		body, ok := file.Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		fileRule, enabled := rulehelper.ForFile(r, filename)
+		if !enabled {
+			continue
+		}
+
+		fileRule.checkOrder(ignores, body.Blocks)
+		if fileRule.Config.Alphabetical.Enabled() {
+			fileRule.checkAlphabetical(ignores, body.Blocks)
+		}
+	}
+
+	return ignores.ReportIgnores()
+}
+
+// checkOrder reports the first block of a file whose type comes before that
+// of a block above it in the configured order.
+func (r *Rule) checkOrder(runner tflint.Runner, blocks hclsyntax.Blocks) {
+	latest := -1
+	for _, block := range blocks {
+		rank := slices.Index(r.Config.Order, block.Type)
+		if rank < 0 {
+			continue
+		}
+		if rank < latest {
+			message := fmt.Sprintf(MisOrderedMessage, rulehelper.Capitalize(block.Type), r.Config.Order[latest])
+			rulehelper.EmitIssue(runner, r, "order", "", message, rulehelper.DefRange(block.AsHCLBlock()))
+			return
+		}
+		latest = rank
+	}
+}
+
+// checkAlphabetical reports, for each type of sortedTypes, the first block of
+// a file whose name comes before that of a block of its type above it.
+func (r *Rule) checkAlphabetical(runner tflint.Runner, blocks hclsyntax.Blocks) {
+	for _, typ := range sortedTypes {
+		var names []string
+		for _, block := range blocks {
+			if block.Type != typ || len(block.Labels) == 0 {
+				continue
+			}
+			name := block.Labels[0]
+			if i := slices.IndexFunc(names, func(above string) bool { return above > name }); i >= 0 {
+				message := fmt.Sprintf(UnsortedMessage, rulehelper.Capitalize(typ), name, names[i])
+				rulehelper.EmitIssue(runner, r, "alphabetical", r.Config.Alphabetical.Level(), message, rulehelper.DefRange(block.AsHCLBlock()))
+				break
+			}
+			names = append(names, name)
+		}
+	}
+}
+
+// NewBlockOrderRule returns a new rule.
+func NewBlockOrderRule() *Rule {
+	rule := &Rule{}
+	rule.Config = defaultBlockOrderConfig
+	return rule
+}
+
+// ForFile returns the rule with the config that applies to filename.
+func (r *Rule) ForFile(filename string) *Rule {
+	config, ok := r.overrides.Match(filename)
+	if !ok {
+		return r
+	}
+	fileRule := *r
+	fileRule.Config = config
+	return &fileRule
+}
+
+// Enabled returns whether the rule is enabled by default.
+func (r *Rule) Enabled() bool {
+	return r.Config.Enabled == nil || *r.Config.Enabled
+}
+
+// Link returns the rule reference link.
+func (r *Rule) Link() string {
+	return "https://github.com/tfctl/tflint-ruleset-elements-of-style/blob/main/docs/rules/eos_block_order.md"
+}
+
+// Name returns the rule name.
+func (r *Rule) Name() string {
+	if r.RuleName != "" {
+		return r.RuleName
+	}
+
+	return "eos_block_order"
+}
+
+// Severity returns the rule severity.
+func (r *Rule) Severity() tflint.Severity {
+	return rulehelper.ToSeverity(r.Config.Level)
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package blockorder
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/testhelper"
+)
+
+func TestBlockOrder(t *testing.T) {
+	if !flag.Parsed() {
+		flag.Parse()
+	}
+
+	t.Run("Config", testBlockOrderConfig)
+	t.Run("ConfigErrors", testBlockOrderConfigErrors)
+
+	t.Run("Levels", testBlockOrderLevels)
+	t.Run("Rule", testBlockOrderRule)
+}
+
+func testBlockOrderConfig(t *testing.T) {
+	cases := []testhelper.ConfigTestCase{
+		{
+			Name: "eos_block_order",
+			Want: defaultBlockOrderConfig,
+		},
+		{
+			Name: "eos_block_order_alphabetical",
+			Want: func() blockOrderConfig {
+				cfg := defaultBlockOrderConfig
+				cfg.Alphabetical = "error"
+				cfg.Order = []string{"terraform", "variable", "resource", "output"}
+				return cfg
+			}(),
+		},
+	}
+
+	testhelper.ConfigTestRunner(t, defaultBlockOrderConfig, cases)
+}
+
+func testBlockOrderConfigErrors(t *testing.T) {
+	cases := []testhelper.ConfigErrorTestCase{
+		{
+			Name: "eos_block_order_bad_type",
+			Want: `rule "eos_block_order_bad_type": "order" must list block types, one of terraform, provider, variable, locals, output, module, resource, data, ephemeral, check, moved, import, removed, not "providers"`,
+		},
+		{
+			Name: "eos_block_order_duplicate",
+			Want: `rule "eos_block_order_duplicate": "order" lists "resource" more than once`,
+		},
+		{
+			Name: "eos_block_order_typo",
+			Want: `rule "eos_block_order_typo": unknown option "alphabetic" at testdata/.tflint_test.hcl:26,3-13. Did you mean "alphabetical"? Valid options: alphabetical, enabled, level, order, override { files, ... }.`,
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewBlockOrderRule() }
+	testhelper.ConfigErrorTestRunner(t, ruleFactory, cases)
+}
+
+func testBlockOrderRule(t *testing.T) {
+	content, _ := os.ReadFile("./testdata/block_order_test.tf")
+
+	cases := []testhelper.RuleTestCase{
+		{
+			Name:    "eos_block_order",
+			Content: string(content),
+			Want:    []string{},
+		},
+		{
+			Name:    "eos_block_order_alphabetical",
+			Content: string(content),
+			Want: []string{
+				fmt.Sprintf(UnsortedMessage, "Variable", "bucket", "region"),
+				fmt.Sprintf(UnsortedMessage, "Output", "bucket_arn", "bucket_name"),
+			},
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewBlockOrderRule() }
+	testhelper.RuleTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "block_order_test.tf")
+}
+
+func testBlockOrderLevels(t *testing.T) {
+	cases := []testhelper.SeverityTestCase{
+		{
+			Name: "eos_block_order_alphabetical",
+			Content: `variable "zone" {
+  type = string
+}
+
+variable "region" {
+  type = string
+}
+
+output "zone" {
+  value = var.zone
+}
+
+variable "az" {
+  type = string
+}
+`,
+			Want: map[string]tflint.Severity{
+				fmt.Sprintf(MisOrderedMessage, "Variable", "output"):       tflint.WARNING,
+				fmt.Sprintf(UnsortedMessage, "Variable", "region", "zone"): tflint.ERROR,
+			},
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewBlockOrderRule() }
+	testhelper.SeverityTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "block_order_levels.tf")
+}
@@ -0,0 +1,27 @@
+# Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+# SPDX-License-Identifier: Apache-2.0
+
+rule "eos_block_order" {
+  enabled = true
+}
+
+rule "eos_block_order_alphabetical" {
+  enabled      = true
+  alphabetical = "error"
+  order        = ["terraform", "variable", "resource", "output"]
+}
+
+rule "eos_block_order_bad_type" {
+  enabled = true
+  order   = ["terraform", "providers"]
+}
+
+rule "eos_block_order_duplicate" {
+  enabled = true
+  order   = ["resource", "data", "resource"]
+}
+
+rule "eos_block_order_typo" {
+  enabled    = true
+  alphabetic = true
+}
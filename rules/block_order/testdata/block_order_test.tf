@@ -0,0 +1,41 @@
+# Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+# SPDX-License-Identifier: Apache-2.0
+
+terraform {
+  required_version = ">= 1.5"
+}
+
+variable "region" {
+  description = "The region to deploy to."
+  type        = string
+}
+
+provider "aws" {
+  region = var.region
+}
+
+variable "bucket" {
+  description = "The name of the bucket."
+  type        = string
+}
+
+resource "aws_s3_bucket" "logs" {
+  bucket = var.bucket
+}
+
+# eos-ignore: block_order.order -- the lookup depends on the bucket
+data "aws_iam_policy_document" "logs" {
+  statement {
+    resources = [aws_s3_bucket.logs.arn]
+  }
+}
+
+output "bucket_name" {
+  description = "The name of the bucket."
+  value       = aws_s3_bucket.logs.bucket
+}
+
+output "bucket_arn" {
+  description = "The ARN of the bucket."
+  value       = aws_s3_bucket.logs.arn
+}
@@ -4,9 +4,12 @@
 package comment
 
 import (
+	"fmt"
+	"slices"
+	"strings"
+
 	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
 
-	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 )
@@ -17,31 +20,85 @@ type lengthConfig struct {
 	AllowURL *bool `hclext:"allow_url,optional" hcl:"allow_url,optional"`
 	// Maximum allowed column for comments. <=0 >= 99 effectively disable check.
 	Column int `hclext:"column,optional" hcl:"column,optional"`
+	// Issue level for long comments. Defaults to the rule level.
+	Level string `hclext:"level,optional" hcl:"level,optional"`
+	// Number of columns between tab stops.
+	TabWidth int `hclext:"tab_width,optional" hcl:"tab_width,optional"`
+}
+
+// requireDocConfig represents the configuration for the doc comment check.
+type requireDocConfig struct {
+	// Block types that must have a doc comment. Defaults to
+	// defaultRequireDocBlocks.
+	Blocks []string `hclext:"blocks,optional" hcl:"blocks,optional"`
+	// Issue level for undocumented blocks. Defaults to the rule level.
+	Level string `hclext:"level,optional" hcl:"level,optional"`
+	// Blocks whose bodies are shorter than this many lines are exempt.
+	MinLines int `hclext:"min_lines,optional" hcl:"min_lines,optional"`
+	// Minimum number of words in a doc comment.
+	MinWords int `hclext:"min_words,optional" hcl:"min_words,optional"`
 }
 
 // commentsRuleConfig represents the configuration for the CommentsRule.
 type commentsRuleConfig struct {
 	Enabled *bool `hclext:"enabled,optional" hcl:"enabled,optional"`
-	// Enable block /* */ comment check.
-	Block bool `hclext:"block,optional" hcl:"block,optional"`
-	// Enable EOL comment check.
-	EOL    bool          `hclext:"eol,optional" hcl:"eol,optional"`
-	Jammed bool          `hclext:"jammed,optional" hcl:"jammed,optional"`
-	Length *lengthConfig `hclext:"length,block" hcl:"length,block"`
+	// Enable block /* */ comment check, optionally at its own level.
+	Block rulehelper.SubCheck `hclext:"block,optional" hcl:"block,optional"`
+	// Enable EOL comment check, optionally at its own level.
+	EOL    rulehelper.SubCheck `hclext:"eol,optional" hcl:"eol,optional"`
+	Jammed rulehelper.SubCheck `hclext:"jammed,optional" hcl:"jammed,optional"`
+	Length *lengthConfig       `hclext:"length,block" hcl:"length,block"`
 	// Issue level.
 	Level string `hclext:"level,optional" hcl:"level,optional"`
+	// Require doc comments on blocks. Off unless the block is present.
+	RequireDoc *requireDocConfig `hclext:"require_doc,block" hcl:"require_doc,block"`
 	// Minimum ration threshold of comments to code PER SOURCE FILE.
 	Threshold *float64 `hclext:"threshold,optional" hcl:"threshold,optional"`
+	// What the threshold is measured over: each file, or each top-level block
+	// and local. Defaults to file.
+	ThresholdScope string `hclext:"threshold_scope,optional" hcl:"threshold_scope,optional"`
+}
+
+// Validate checks that the threshold is a ratio within 0..1 over a known
+// scope, that the length tab width is positive, and that require_doc names
+// block types and sets no negative minimum.
+func (c *commentsRuleConfig) Validate() error {
+	if c.Threshold != nil && (*c.Threshold < 0 || *c.Threshold > 1) {
+		return fmt.Errorf("\"threshold\" must be within 0..1, not %g", *c.Threshold)
+	}
+	switch c.ThresholdScope {
+	case "", thresholdScopeFile, thresholdScopeBlock:
+	default:
+		return fmt.Errorf("\"threshold_scope\" must be %s or %s, not %q", thresholdScopeFile, thresholdScopeBlock, c.ThresholdScope)
+	}
+	if c.Length != nil && c.Length.TabWidth < 1 {
+		return fmt.Errorf("\"length.tab_width\" must be at least 1, not %d", c.Length.TabWidth)
+	}
+	if doc := c.RequireDoc; doc != nil {
+		for _, typ := range doc.Blocks {
+			if !slices.Contains(requireDocBlockTypes, typ) {
+				return fmt.Errorf("\"require_doc.blocks\" must list block types (%s), not %q", strings.Join(requireDocBlockTypes, ", "), typ)
+			}
+		}
+		if doc.MinLines < 0 {
+			return fmt.Errorf("\"require_doc.min_lines\" must be at least 0, not %d", doc.MinLines)
+		}
+		if doc.MinWords < 0 {
+			return fmt.Errorf("\"require_doc.min_words\" must be at least 0, not %d", doc.MinWords)
+		}
+	}
+	return nil
 }
 
 // defaultCommentsConfig is the default configuration for the CommentsRule.
 var defaultCommentsConfig = commentsRuleConfig{
-	Block:  true,
-	EOL:    true,
-	Jammed: true,
+	Block:  "true",
+	EOL:    "true",
+	Jammed: "true",
 	Length: &lengthConfig{
 		AllowURL: func() *bool { b := true; return &b }(),
 		Column:   80,
+		TabWidth: rulehelper.DefaultTabWidth,
 	},
 	Level: "warning",
 }
@@ -53,41 +110,43 @@ type Rule struct {
 	// RuleName is the rule block name to load from the config file. If empty,
 	// defaults to "eos_comments".
 	RuleName string
-	// ConfigFile is the path to the config file. If empty, LoadRuleConfig will
-	// search CWD then $HOME for .tflint.hcl.
-	ConfigFile string
+	// overrides are the override blocks of the rule block, applied by ForFile.
+	overrides rulehelper.Overrides[commentsRuleConfig]
 }
 
 // Check checks whether the rule conditions are met.
 func (r *Rule) Check(runner tflint.Runner) error {
-	// Load config using the rule name and optional config file path.
-	if err := rulehelper.LoadRuleConfig(r.Name(), &r.Config, r.ConfigFile); err != nil {
+	ignores, overrides, err := rulehelper.BeginCheck(runner, r, &r.Config)
+	if err != nil || ignores == nil {
 		return err
 	}
-
-	// Bail out early if the rule is not enabled. This will occur if the EOS
-	// plugin is enabled, but this specific rule is not.
-	if !r.Enabled() {
-		return nil
-	}
+	r.overrides = overrides
 
 	// The threshold check is done first as it's parsing and checking an entire
 	// source file as opposed to parsing an entire set of source files and then
 	// checking each comment.
-	if err := checkThreshold(r, runner); err != nil {
+	if err := checkThreshold(r, rulehelper.SubRule(ignores, "threshold")); err != nil {
+		return err
+	}
+
+	if err := checkRequireDoc(r, rulehelper.SubRule(ignores, "require_doc")); err != nil {
 		return err
 	}
 
-	return checkCommentsWithContext(runner, r,
+	if err := checkCommentsWithContext(ignores, r,
 		checkBlock,
 		checkEOL,
 		checkJammed,
-		checkLength)
+		checkLength); err != nil {
+		return err
+	}
+	return ignores.ReportIgnores()
 }
 
-// checkCommentsWithContext iterates over all files in the root module, parses
-// them, and applies the check function to each comment token, providing the
-// previous token for context.
+// checkCommentsWithContext iterates over all native files in the root module,
+// parses them, and applies the check function to each comment token, providing
+// the previous token for context. Each file is checked with the rule
+// configured for it.
 func checkCommentsWithContext(
 	runner tflint.Runner,
 	rule *Rule,
@@ -99,15 +158,24 @@ func checkCommentsWithContext(
 	}
 
 	for filename, file := range files {
-		tokens, diags := hclsyntax.LexConfig(file.Bytes, filename, hcl.InitialPos)
+		// JSON has no comments to check.
+		if !rulehelper.IsNative(file) {
+			continue
+		}
+
+		rule, enabled := rulehelper.ForFile(rule, filename)
+		if !enabled {
+			continue
+		}
+
+		source := rulehelper.Source(filename, file)
+		tokens, diags := source.Tokens()
 		if diags.HasErrors() {
 			return diags
 		}
 
-		for i, token := range tokens {
-			if token.Type != hclsyntax.TokenComment {
-				continue
-			}
+		for _, i := range source.Comments() {
+			token := tokens[i]
 
 			var prevToken *hclsyntax.Token
 			for j := i - 1; j >= 0; j-- {
@@ -130,10 +198,25 @@ func checkCommentsWithContext(
 func NewCommentsRule() *Rule {
 	rule := &Rule{}
 	rule.Config = defaultCommentsConfig
+	// Decoding a length block writes into the struct, so the default must not
+	// be shared.
+	length := *defaultCommentsConfig.Length
+	rule.Config.Length = &length
 
 	return rule
 }
 
+// ForFile returns the rule with the config that applies to filename.
+func (r *Rule) ForFile(filename string) *Rule {
+	config, ok := r.overrides.Match(filename)
+	if !ok {
+		return r
+	}
+	fileRule := *r
+	fileRule.Config = config
+	return &fileRule
+}
+
 // Enabled returns whether the rule is enabled by default.
 func (r *Rule) Enabled() bool {
 	return r.Config.Enabled == nil || *r.Config.Enabled
@@ -6,6 +6,9 @@ package comment
 import (
 	"strings"
 
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
+	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/terraform-linters/tflint-plugin-sdk/logger"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
@@ -15,13 +18,93 @@ const avoidBlockCommentsMessage = "Avoid block comments."
 
 // checkBlock checks if block comments are used.
 func checkBlock(r *Rule, text string, runner tflint.Runner, token hclsyntax.Token, _ *hclsyntax.Token) {
-	if r.Config.Block {
+	if r.Config.Block.Enabled() {
 		if strings.HasPrefix(text, "/*") {
 			message := avoidBlockCommentsMessage
-			if err := runner.EmitIssue(r, message, token.Range); err != nil {
+			if err := rulehelper.SubRule(runner, "block").EmitIssueWithFix(rulehelper.WithLevel(r, r.Config.Block.Level()), message, token.Range, func(f tflint.Fixer) error {
+				return fixBlock(f, sourceOf(runner, token.Range), text, token.Range)
+			}); err != nil {
 				logger.Error(err.Error())
 			}
 			logger.Debug(message)
 		}
 	}
 }
+
+// fixBlock replaces a block comment with a run of line comments at the same
+// indentation. Only a block comment that sits alone on its lines can be
+// converted, as a line comment would swallow any code that follows it.
+func fixBlock(f tflint.Fixer, src []byte, text string, rng hcl.Range) error {
+	if src == nil {
+		return tflint.ErrFixNotSupported
+	}
+
+	lineStart := lineStartOf(src, rng.Start)
+	if !isBlank(src[lineStart.Byte:rng.Start.Byte]) || !isBlank(restOfLine(src, rng.End)) {
+		return tflint.ErrFixNotSupported
+	}
+
+	indent := indentOf(src, rng.Start)
+	lines := blockCommentLines(text)
+	for i, line := range lines {
+		if line == "" {
+			lines[i] = "#"
+		} else {
+			lines[i] = "# " + line
+		}
+	}
+	if len(lines) == 0 {
+		lines = []string{"#"}
+	}
+
+	return f.ReplaceText(rng, strings.Join(lines, "\n"+indent))
+}
+
+// blockCommentLines returns the text lines of a block comment without its
+// markers. A leading '*' gutter is removed, as is the indentation shared by
+// the continuation lines. Leading and trailing blank lines are dropped.
+func blockCommentLines(text string) []string {
+	body := strings.TrimSuffix(strings.TrimPrefix(text, "/*"), "*/")
+	lines := strings.Split(body, "\n")
+	for i := range lines {
+		lines[i] = strings.TrimRight(lines[i], " \t\r")
+	}
+
+	// The first line follows the marker, so its indentation is meaningless.
+	lines[0] = strings.TrimLeft(strings.TrimLeft(lines[0], "*"), " \t")
+
+	rest := lines[1:]
+	gutter := len(rest) > 0
+	shared := -1
+	for _, line := range rest {
+		trimmed := strings.TrimLeft(line, " \t")
+		if trimmed == "" {
+			continue
+		}
+		if !strings.HasPrefix(trimmed, "*") {
+			gutter = false
+		}
+		if n := len(line) - len(trimmed); shared < 0 || n < shared {
+			shared = n
+		}
+	}
+
+	for i, line := range rest {
+		switch {
+		case strings.TrimSpace(line) == "":
+			rest[i] = ""
+		case gutter:
+			rest[i] = strings.TrimPrefix(strings.TrimPrefix(strings.TrimLeft(line, " \t"), "*"), " ")
+		default:
+			rest[i] = line[shared:]
+		}
+	}
+
+	for len(lines) > 0 && lines[0] == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
@@ -28,3 +28,19 @@ func testCommentsBlockRule(t *testing.T) {
 	ruleFactory := func() tflint.Rule { return NewCommentsRule() }
 	testhelper.RuleTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "comments_block.tf")
 }
+
+func testCommentsBlockFix(t *testing.T) {
+	content, _ := os.ReadFile("./testdata/comments_block_fix.tf")
+	fixed, _ := os.ReadFile("./testdata/comments_block_fix_fixed.tf")
+
+	cases := []testhelper.FixTestCase{
+		{
+			Name:    "eos_comments",
+			Content: string(content),
+			Want:    string(fixed),
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewCommentsRule() }
+	testhelper.FixTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "comments_block_fix.tf")
+}
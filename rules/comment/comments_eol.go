@@ -4,6 +4,11 @@
 package comment
 
 import (
+	"strings"
+
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
+	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/terraform-linters/tflint-plugin-sdk/logger"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
@@ -12,22 +17,60 @@ import (
 const avoidEOLCommentsMessage = "Avoid EOL comments."
 
 // checkEOL checks if EOL comments are used.
-func checkEOL(r *Rule, _ string, runner tflint.Runner, token hclsyntax.Token, prevToken *hclsyntax.Token) {
-	if !r.Config.EOL {
+func checkEOL(r *Rule, text string, runner tflint.Runner, token hclsyntax.Token, prevToken *hclsyntax.Token) {
+	if !r.Config.EOL.Enabled() {
 		return
 	}
 
-	if prevToken != nil {
-		if prevToken.Type == hclsyntax.TokenNewline {
-			return
+	if isEOL(prevToken, token) {
+		message := avoidEOLCommentsMessage
+		if err := rulehelper.SubRule(runner, "eol").EmitIssueWithFix(rulehelper.WithLevel(r, r.Config.EOL.Level()), message, token.Range, func(f tflint.Fixer) error {
+			return fixEOL(r, f, sourceOf(runner, token.Range), text, token, prevToken)
+		}); err != nil {
+			logger.Error(err.Error())
 		}
+		logger.Debug(message)
+	}
+}
 
-		if prevToken.Range.End.Line == token.Range.Start.Line {
-			message := avoidEOLCommentsMessage
-			if err := runner.EmitIssue(r, message, token.Range); err != nil {
-				logger.Error(err.Error())
-			}
-			logger.Debug(message)
-		}
+// isEOL reports whether the comment token follows code on the same line.
+func isEOL(prevToken *hclsyntax.Token, token hclsyntax.Token) bool {
+	if prevToken == nil || prevToken.Type == hclsyntax.TokenNewline {
+		return false
+	}
+	return prevToken.Range.End.Line == token.Range.Start.Line
+}
+
+// fixEOL moves an EOL comment onto its own line above the line it trails,
+// using the indentation of that line.
+func fixEOL(r *Rule, f tflint.Fixer, src []byte, text string, token hclsyntax.Token, prevToken *hclsyntax.Token) error {
+	if src == nil {
+		return tflint.ErrFixNotSupported
+	}
+
+	// Only the code and whitespace may precede the comment. A second comment on
+	// the same line would make the two moves overlap.
+	gap := hcl.Range{Filename: token.Range.Filename, Start: prevToken.Range.End, End: token.Range.Start}
+	if !isBlank(src[gap.Start.Byte:gap.End.Byte]) {
+		return tflint.ErrFixNotSupported
+	}
+
+	comment := strings.TrimRight(text, "\r\n")
+	if r.Config.Jammed.Enabled() {
+		comment = unjam(comment)
+	}
+
+	// Line comments own their trailing newline, so it is put back when the
+	// comment is removed.
+	newline := ""
+	if strings.HasSuffix(text, "\n") {
+		newline = "\n"
+	}
+
+	lineStart := lineStartOf(src, token.Range.Start)
+	indent := indentOf(src, token.Range.Start)
+	if err := f.InsertTextBefore(hcl.Range{Filename: token.Range.Filename, Start: lineStart, End: lineStart}, indent+comment+"\n"); err != nil {
+		return err
 	}
+	return f.ReplaceText(hcl.Range{Filename: token.Range.Filename, Start: gap.Start, End: token.Range.End}, newline)
 }
@@ -26,3 +26,19 @@ func testCommentsEOLRule(t *testing.T) {
 	ruleFactory := func() tflint.Rule { return NewCommentsRule() }
 	testhelper.RuleTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "comments_eol.tf")
 }
+
+func testCommentsEOLFix(t *testing.T) {
+	content, _ := os.ReadFile("./testdata/comments_eol_fix.tf")
+	fixed, _ := os.ReadFile("./testdata/comments_eol_fix_fixed.tf")
+
+	cases := []testhelper.FixTestCase{
+		{
+			Name:    "eos_comments",
+			Content: string(content),
+			Want:    string(fixed),
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewCommentsRule() }
+	testhelper.FixTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "comments_eol_fix.tf")
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package comment
+
+import (
+	"bytes"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// sourceOf returns the source bytes of the file holding rng, or nil if the
+// runner can't provide them.
+func sourceOf(runner tflint.Runner, rng hcl.Range) []byte {
+	file, err := runner.GetFile(rng.Filename)
+	if err != nil || file == nil {
+		return nil
+	}
+	return file.Bytes
+}
+
+// lineStartOf returns the position of the first byte on the line holding pos.
+func lineStartOf(src []byte, pos hcl.Pos) hcl.Pos {
+	start := bytes.LastIndexByte(src[:pos.Byte], '\n') + 1
+	return hcl.Pos{Line: pos.Line, Column: 1, Byte: start}
+}
+
+// indentOf returns the leading whitespace of the line holding pos.
+func indentOf(src []byte, pos hcl.Pos) string {
+	line := src[lineStartOf(src, pos).Byte:pos.Byte]
+	return string(line[:len(line)-len(bytes.TrimLeft(line, " \t"))])
+}
+
+// isBlank reports whether b holds nothing but whitespace.
+func isBlank(b []byte) bool {
+	return len(bytes.TrimSpace(b)) == 0
+}
+
+// restOfLine returns the bytes that follow pos up to, but excluding, the end
+// of its line.
+func restOfLine(src []byte, pos hcl.Pos) []byte {
+	rest := src[pos.Byte:]
+	if end := bytes.IndexByte(rest, '\n'); end >= 0 {
+		rest = rest[:end]
+	}
+	return rest
+}
@@ -8,6 +8,9 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
+	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/terraform-linters/tflint-plugin-sdk/logger"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
@@ -18,8 +21,8 @@ import (
 var jammedCommentParser = regexp.MustCompile(`^\s*(///*|##*|/\*\**)([^\s/#])`)
 
 // checkJammed checks if comments are jammed (no space after delimiter).
-func checkJammed(r *Rule, text string, runner tflint.Runner, token hclsyntax.Token, _ *hclsyntax.Token) {
-	if r.Config.Jammed {
+func checkJammed(r *Rule, text string, runner tflint.Runner, token hclsyntax.Token, prevToken *hclsyntax.Token) {
+	if r.Config.Jammed.Enabled() {
 		if jammedCommentParser.MatchString(text) {
 			trimmed := strings.TrimSpace(text)
 			rns := []rune(trimmed)
@@ -28,10 +31,43 @@ func checkJammed(r *Rule, text string, runner tflint.Runner, token hclsyntax.Tok
 				snippet = string(rns[:5])
 			}
 			message := fmt.Sprintf("Avoid jammed comment ('%s ...').", snippet)
-			if err := runner.EmitIssue(r, message, token.Range); err != nil {
+			if err := rulehelper.SubRule(runner, "jammed").EmitIssueWithFix(rulehelper.WithLevel(r, r.Config.Jammed.Level()), message, token.Range, func(f tflint.Fixer) error {
+				// The block and EOL fixes rewrite the whole comment and unjam it
+				// as they go, so leave those comments to them.
+				if r.Config.Block.Enabled() && strings.HasPrefix(text, "/*") {
+					return tflint.ErrFixNotSupported
+				}
+				if r.Config.EOL.Enabled() && isEOL(prevToken, token) {
+					return tflint.ErrFixNotSupported
+				}
+				return fixJammed(f, text, token.Range)
+			}); err != nil {
 				logger.Error(err.Error())
 			}
 			logger.Debug(message)
 		}
 	}
 }
+
+// fixJammed inserts a space between the comment marker and the comment text.
+func fixJammed(f tflint.Fixer, text string, rng hcl.Range) error {
+	loc := jammedCommentParser.FindStringSubmatchIndex(text)
+	if loc == nil {
+		return tflint.ErrFixNotSupported
+	}
+
+	pos := rng.Start
+	pos.Byte += loc[3]
+	pos.Column += loc[3]
+	return f.InsertTextBefore(hcl.Range{Filename: rng.Filename, Start: pos, End: pos}, " ")
+}
+
+// unjam returns the comment text with a space inserted after the marker if the
+// comment is jammed.
+func unjam(text string) string {
+	loc := jammedCommentParser.FindStringSubmatchIndex(text)
+	if loc == nil {
+		return text
+	}
+	return text[:loc[3]] + " " + text[loc[3]:]
+}
@@ -31,3 +31,19 @@ func testCommentsJammedRule(t *testing.T) {
 	ruleFactory := func() tflint.Rule { return NewCommentsRule() }
 	testhelper.RuleTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "comments_jammed.tf")
 }
+
+func testCommentsJammedFix(t *testing.T) {
+	content, _ := os.ReadFile("./testdata/comments_jammed_fix.tf")
+	fixed, _ := os.ReadFile("./testdata/comments_jammed_fix_fixed.tf")
+
+	cases := []testhelper.FixTestCase{
+		{
+			Name:    "eos_comments",
+			Content: string(content),
+			Want:    string(fixed),
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewCommentsRule() }
+	testhelper.FixTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "comments_jammed_fix.tf")
+}
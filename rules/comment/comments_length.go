@@ -7,16 +7,24 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/terraform-linters/tflint-plugin-sdk/logger"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 )
 
-// checkLength checks if comments exceed the column limit.
+// checkLength checks if comments exceed the column limit. The column is
+// measured in display columns, so tabs and wide characters before or in the
+// comment count as a reader sees them.
 func checkLength(r *Rule, text string, runner tflint.Runner, token hclsyntax.Token, _ *hclsyntax.Token) {
 	if r.Config.Length.Column > 0 {
 		trimmedText := strings.TrimRight(text, "\r\n")
-		end := token.Range.Start.Column + len(trimmedText) - 1
+		prefix := strings.Repeat(" ", token.Range.Start.Column-1)
+		if src := sourceOf(runner, token.Range); src != nil {
+			prefix = string(src[lineStartOf(src, token.Range.Start).Byte:token.Range.Start.Byte])
+		}
+		end := rulehelper.DisplayWidth(prefix+trimmedText, r.Config.Length.TabWidth)
 
 		if *r.Config.Length.AllowURL {
 			// Simple URL detection.
@@ -27,7 +35,7 @@ func checkLength(r *Rule, text string, runner tflint.Runner, token hclsyntax.Tok
 
 		if end > r.Config.Length.Column {
 			message := fmt.Sprintf("Wrap comment at column %d (currently %d).", r.Config.Length.Column, end)
-			if err := runner.EmitIssue(r, message, token.Range); err != nil {
+			if err := rulehelper.SubRule(runner, "length").EmitIssue(rulehelper.WithLevel(r, r.Config.Length.Level), message, token.Range); err != nil {
 				logger.Error(err.Error())
 			}
 			logger.Debug(message)
@@ -24,6 +24,15 @@ func testCommentsLengthRule(t *testing.T) {
 				"Wrap comment at column 80 (currently 106).",
 			},
 		},
+		{
+			// With a tab width of 8 the comment ends at column 41. With the
+			// default of 4 it would end at 37.
+			Name:    "eos_comments_tab_width",
+			Content: "locals {\n\t# The tab takes up eight columns.\n\tname = \"web\"\n}\n",
+			Want: []string{
+				"Wrap comment at column 40 (currently 41).",
+			},
+		},
 	}
 
 	ruleFactory := func() tflint.Rule { return NewCommentsRule() }
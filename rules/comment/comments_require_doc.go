@@ -0,0 +1,94 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package comment
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// requireDocBlockTypes are the block types require_doc can be asked to check.
+var requireDocBlockTypes = []string{
+	"check", "data", "ephemeral", "locals", "module", "output", "provider", "resource", "variable",
+}
+
+// defaultRequireDocBlocks are the block types checked when require_doc doesn't
+// list any.
+var defaultRequireDocBlocks = []string{"resource", "module", "data", "locals"}
+
+// checkRequireDoc checks that the top-level blocks of the configured types have
+// a doc comment, i.e. a comment directly above them, of at least the minimum
+// number of words.
+func checkRequireDoc(rule *Rule, runner tflint.Runner) error {
+	files, err := runner.GetFiles()
+	if err != nil {
+		return err
+	}
+
+	for filename, file := range files {
+		// JSON has no comments to document blocks with.
+		body, ok := file.Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		r, enabled := rulehelper.ForFile(rule, filename)
+		if !enabled || r.Config.RequireDoc == nil {
+			continue
+		}
+		config := r.Config.RequireDoc
+		types := config.Blocks
+		if len(types) == 0 {
+			types = defaultRequireDocBlocks
+		}
+
+		comments := rulehelper.Source(filename, file).CommentMap()
+		for _, block := range body.Blocks {
+			if !slices.Contains(types, block.Type) {
+				continue
+			}
+
+			// Short blocks speak for themselves.
+			if lines := block.CloseBraceRange.Start.Line - block.OpenBraceRange.End.Line - 1; config.MinLines > 0 && lines < config.MinLines {
+				continue
+			}
+
+			var message string
+			doc := comments.Doc(block)
+			switch {
+			case doc == nil:
+				message = fmt.Sprintf("Document %s with a comment above it.", blockName(block))
+			case len(strings.Fields(doc.Text())) < config.MinWords:
+				message = fmt.Sprintf("Doc comment of %s should have at least %d words (has %d).",
+					blockName(block), config.MinWords, len(strings.Fields(doc.Text())))
+			default:
+				continue
+			}
+
+			rng := rulehelper.DefRange(block.AsHCLBlock())
+			if err := runner.EmitIssue(rulehelper.WithLevel(r, config.Level), message, rng); err != nil {
+				logger.Error(err.Error())
+			}
+		}
+	}
+
+	return nil
+}
+
+// blockName returns the type and labels of block as written, e.g.
+// `module "vpc"`.
+func blockName(block *hclsyntax.Block) string {
+	name := block.Type
+	for _, label := range block.Labels {
+		name += fmt.Sprintf(" %q", label)
+	}
+	return name
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package comment
+
+import (
+	"os"
+	"testing"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/testhelper"
+)
+
+func testCommentsRequireDocRule(t *testing.T) {
+	content, _ := os.ReadFile("./testdata/comments_require_doc.tf")
+
+	cases := []testhelper.RuleTestCase{
+		{
+			Name:    "eos_comments",
+			Content: string(content),
+			Want:    []string{},
+		},
+		{
+			Name:    "eos_comments_require_doc",
+			Content: string(content),
+			Want: []string{
+				`Document module "dns" with a comment above it.`,
+				`Document data "terraform_remote_state" "core" with a comment above it.`,
+				`Document locals with a comment above it.`,
+			},
+		},
+		{
+			Name:    "eos_comments_require_doc_strict",
+			Content: string(content),
+			Want: []string{
+				`Document module "dns" with a comment above it.`,
+				`Doc comment of variable "zone" should have at least 4 words (has 2).`,
+			},
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewCommentsRule() }
+	testhelper.RuleTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "comments_require_doc.tf")
+
+	severityCases := []testhelper.SeverityTestCase{
+		{
+			Name:    "eos_comments_require_doc_strict",
+			Content: string(content),
+			Want: map[string]tflint.Severity{
+				`Document module "dns" with a comment above it.`:                       tflint.NOTICE,
+				`Doc comment of variable "zone" should have at least 4 words (has 2).`: tflint.NOTICE,
+			},
+		},
+	}
+
+	testhelper.SeverityTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", severityCases, "comments_require_doc.tf")
+}
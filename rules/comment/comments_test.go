@@ -6,8 +6,10 @@ package comment
 
 import (
 	"flag"
+	"os"
 	"testing"
 
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
 	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/testhelper"
 )
@@ -18,25 +20,92 @@ func TestComments(t *testing.T) {
 	}
 
 	t.Run("Block", testCommentsBlockRule)
+	t.Run("BlockFix", testCommentsBlockFix)
 	t.Run("EOL", testCommentsEOLRule)
+	t.Run("EOLFix", testCommentsEOLFix)
 	t.Run("Jammed", testCommentsJammedRule)
+	t.Run("JammedFix", testCommentsJammedFix)
 	t.Run("Length", testCommentsLengthRule)
+	t.Run("RequireDoc", testCommentsRequireDocRule)
 	t.Run("Threshold", testCommentsThresholdRule)
 	t.Run("Config", testCommentsConfig)
+	t.Run("ConfigErrors", testCommentsConfigErrors)
+	t.Run("Levels", testCommentsLevels)
+	t.Run("Override", testCommentsOverride)
 }
 
 func floatPtr(f float64) *float64 {
 	return &f
 }
 
+func testCommentsOverride(t *testing.T) {
+	content := `#Jammed comment.
+locals {}
+`
+
+	cases := []testhelper.FilesTestCase{
+		{
+			Name: "eos_comments_override",
+			Files: map[string]string{
+				"main.tf":               content,
+				"generated/main.tf":     content,
+				"generated/sub/main.tf": content,
+			},
+			Want: []string{
+				"Avoid jammed comment ('#Jamm ...').",
+				"Avoid jammed comment ('#Jamm ...').",
+			},
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewCommentsRule() }
+	testhelper.FilesTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases)
+}
+
+func testCommentsConfigErrors(t *testing.T) {
+	cases := []testhelper.ConfigErrorTestCase{
+		{
+			Name: "eos_comments_threshold_range",
+			Want: `rule "eos_comments_threshold_range": "threshold" must be within 0..1, not 1.5`,
+		},
+		{
+			Name: "eos_comments_bad_level",
+			Want: `rule "eos_comments_bad_level": "eol" must be true, false or a level (notice, warning, error), not "loud"`,
+		},
+		{
+			Name: "eos_comments_typo",
+			Want: `rule "eos_comments_typo": unknown option "colum" at testdata/.tflint_test.hcl:83,5-10. Did you mean "column"? Valid options: block, enabled, eol, jammed, length { allow_url, column, level, tab_width }, level, require_doc { blocks, level, min_lines, min_words }, threshold, threshold_scope, override { files, ... }.`,
+		},
+		{
+			Name: "eos_comments_threshold_scope",
+			Want: `rule "eos_comments_threshold_scope": "threshold_scope" must be file or block, not "resource"`,
+		},
+		{
+			Name: "eos_comments_require_doc_bad_block",
+			Want: `rule "eos_comments_require_doc_bad_block": "require_doc.blocks" must list block types (check, data, ephemeral, locals, module, output, provider, resource, variable), not "resources"`,
+		},
+		{
+			Name: "eos_comments_require_doc_negative",
+			Want: `rule "eos_comments_require_doc_negative": "require_doc.min_words" must be at least 0, not -1`,
+		},
+		{
+			Name: "eos_comments_tab_width_zero",
+			Want: `rule "eos_comments_tab_width_zero": "length.tab_width" must be at least 1, not 0`,
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewCommentsRule() }
+	testhelper.ConfigErrorTestRunner(t, ruleFactory, cases)
+}
+
 func testCommentsConfig(t *testing.T) {
 	cases := []testhelper.ConfigTestCase{
 		{
 			Name: "eos_comments",
 			Want: commentsRuleConfig{
-				Block:     true,
-				EOL:       true,
-				Jammed:    true,
+				Block:     "true",
+				EOL:       "true",
+				Jammed:    "true",
 				Length:    &lengthConfig{Column: 80, AllowURL: rulehelper.BoolPtr(true)},
 				Threshold: floatPtr(0.2),
 			},
@@ -44,13 +113,13 @@ func testCommentsConfig(t *testing.T) {
 		{
 			Name: "eos_comments_noblock",
 			Want: commentsRuleConfig{
-				Block: false,
+				Block: "false",
 			},
 		},
 		{
 			Name: "eos_comments_nojammed",
 			Want: commentsRuleConfig{
-				Jammed: false,
+				Jammed: "false",
 			},
 		},
 		{
@@ -65,6 +134,12 @@ func testCommentsConfig(t *testing.T) {
 				Length: &lengthConfig{Column: 0, AllowURL: rulehelper.BoolPtr(true)},
 			},
 		},
+		{
+			Name: "eos_comments_require_doc",
+			Want: commentsRuleConfig{
+				RequireDoc: &requireDocConfig{},
+			},
+		},
 		{
 			Name: "eos_comments_nourl",
 			Want: commentsRuleConfig{
@@ -75,3 +150,22 @@ func testCommentsConfig(t *testing.T) {
 
 	testhelper.ConfigTestRunner(t, commentsRuleConfig{}, cases)
 }
+
+func testCommentsLevels(t *testing.T) {
+	content, _ := os.ReadFile("./testdata/comments_levels.tf")
+
+	cases := []testhelper.SeverityTestCase{
+		{
+			Name:    "eos_comments_levels",
+			Content: string(content),
+			Want: map[string]tflint.Severity{
+				"Avoid jammed comment ('#FAIL ...').":       tflint.ERROR,
+				avoidEOLCommentsMessage:                     tflint.NOTICE,
+				"Wrap comment at column 60 (currently 70).": tflint.NOTICE,
+			},
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewCommentsRule() }
+	testhelper.SeverityTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "comments_levels.tf")
+}
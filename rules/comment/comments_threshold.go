@@ -6,75 +6,153 @@ package comment
 import (
 	"fmt"
 
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/terraform-linters/tflint-plugin-sdk/logger"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 )
 
-// checkThreshold checks if the comment ratio is below the threshold.
-func checkThreshold(r *Rule, runner tflint.Runner) error {
-	if r.Config.Threshold == nil {
-		return nil
-	}
-	threshold := *r.Config.Threshold
+// Scopes the comment threshold can be measured over.
+const (
+	thresholdScopeFile  = "file"
+	thresholdScopeBlock = "block"
+)
 
+// checkThreshold checks if the comment ratio of each file, or of each of its
+// top-level blocks and locals, is below the threshold configured for it.
+func checkThreshold(rule *Rule, runner tflint.Runner) error {
 	files, err := runner.GetFiles()
 	if err != nil {
 		return err
 	}
 
 	for filename, file := range files {
-		tokens, diags := hclsyntax.LexConfig(file.Bytes, filename, hcl.InitialPos)
+		// JSON has no comments, so any threshold would fail it.
+		body, ok := file.Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		r, enabled := rulehelper.ForFile(rule, filename)
+		if !enabled || r.Config.Threshold == nil {
+			continue
+		}
+		threshold := *r.Config.Threshold
+
+		source := rulehelper.Source(filename, file)
+		tokens, diags := source.Tokens()
 		if diags.HasErrors() {
 			return diags
 		}
+		lines := countLines(tokens)
 
-		linesWithContent := make(map[int]bool)
-		linesWithComment := make(map[int]bool)
-
-		for _, token := range tokens {
-			if token.Type == hclsyntax.TokenEOF || token.Type == hclsyntax.TokenNewline {
-				continue
+		if r.Config.ThresholdScope != thresholdScopeBlock {
+			rng := hcl.Range{
+				Filename: filename,
+				Start:    hcl.Pos{Line: 1, Column: 1},
+				End:      hcl.Pos{Line: 1, Column: 1},
 			}
+			if ratio, ok := lines.ratio(1, tokens[len(tokens)-1].Range.End.Line); ok && ratio < threshold {
+				message := fmt.Sprintf("Comments ratio is %.0f percent (minimum threshold %.0f percent)", ratio*100, threshold*100)
+				r.emitThreshold(runner, message, rng)
+			}
+			continue
+		}
 
-			startLine := token.Range.Start.Line
-			endLine := token.Range.End.Line
-
-			// Adjust endLine if the token ends at the start of the next line (e.g. # comments)
-			if token.Range.End.Column == 1 && endLine > startLine {
+		// Each block is measured along with its doc and trailing comments.
+		// Other comments above it, e.g. a file header set apart by a blank
+		// line, don't count towards it.
+		comments := source.CommentMap()
+		measure := func(node hclsyntax.Node, name string, rng hcl.Range) {
+			extent := node.Range()
+			if doc := comments.Doc(node); doc != nil {
+				extent = hcl.RangeBetween(doc.Range(), extent)
+			}
+			if trailing := comments.Trailing(node); trailing != nil {
+				extent = hcl.RangeBetween(extent, trailing.Range())
+			}
+			endLine := extent.End.Line
+			if extent.End.Column == 1 && endLine > extent.Start.Line {
 				endLine--
 			}
+			if ratio, ok := lines.ratio(extent.Start.Line, endLine); ok && ratio < threshold {
+				message := fmt.Sprintf("Comments ratio of %s is %.0f percent (minimum threshold %.0f percent)", name, ratio*100, threshold*100)
+				r.emitThreshold(runner, message, rng)
+			}
+		}
 
-			for i := startLine; i <= endLine; i++ {
-				if token.Type == hclsyntax.TokenComment {
-					linesWithComment[i] = true
-				}
-				linesWithContent[i] = true
+		for _, block := range body.Blocks {
+			if block.Type != "locals" {
+				measure(block, blockName(block), rulehelper.DefRange(block.AsHCLBlock()))
+				continue
+			}
+			for _, attr := range block.Body.Attributes {
+				measure(attr, fmt.Sprintf("local %q", attr.Name), attr.SrcRange)
 			}
 		}
+	}
+	return nil
+}
+
+// emitThreshold emits a threshold issue.
+func (r *Rule) emitThreshold(runner tflint.Runner, message string, rng hcl.Range) {
+	if err := runner.EmitIssue(r, message, rng); err != nil {
+		logger.Error(err.Error())
+	}
+	logger.Debug(message)
+}
+
+// lineCounts records which lines of a file hold content, and which of those
+// hold a comment.
+type lineCounts struct {
+	content map[int]bool
+	comment map[int]bool
+}
 
-		totalLines := len(linesWithContent)
-		commentLines := len(linesWithComment)
+// countLines finds the lines holding content and comments.
+func countLines(tokens hclsyntax.Tokens) lineCounts {
+	lines := lineCounts{content: map[int]bool{}, comment: map[int]bool{}}
 
-		if totalLines == 0 {
+	for _, token := range tokens {
+		if token.Type == hclsyntax.TokenEOF || token.Type == hclsyntax.TokenNewline {
 			continue
 		}
 
-		ratio := float64(commentLines) / float64(totalLines)
-		if ratio < threshold {
-			rng := hcl.Range{
-				Filename: filename,
-				Start:    hcl.Pos{Line: 1, Column: 1},
-				End:      hcl.Pos{Line: 1, Column: 1},
-			}
+		startLine := token.Range.Start.Line
+		endLine := token.Range.End.Line
+
+		// Adjust endLine if the token ends at the start of the next line (e.g. # comments)
+		if token.Range.End.Column == 1 && endLine > startLine {
+			endLine--
+		}
 
-			message := fmt.Sprintf("Comments ratio is %.0f percent (minimum threshold %.0f percent)", ratio*100, threshold*100)
-			if err := runner.EmitIssue(r, message, rng); err != nil {
-				logger.Error(err.Error())
+		for i := startLine; i <= endLine; i++ {
+			if token.Type == hclsyntax.TokenComment {
+				lines.comment[i] = true
 			}
-			logger.Debug(message)
+			lines.content[i] = true
 		}
 	}
-	return nil
+
+	return lines
+}
+
+// ratio returns the ratio of comment lines to content lines from start through
+// end, and false if none of them hold content.
+func (l lineCounts) ratio(start int, end int) (float64, bool) {
+	total, comments := 0, 0
+	for line := start; line <= end; line++ {
+		if l.content[line] {
+			total++
+		}
+		if l.comment[line] {
+			comments++
+		}
+	}
+	if total == 0 {
+		return 0, false
+	}
+	return float64(comments) / float64(total), true
 }
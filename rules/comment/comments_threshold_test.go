@@ -32,4 +32,40 @@ func testCommentsThresholdRule(t *testing.T) {
 
 	ruleFactory := func() tflint.Rule { return NewCommentsRule() }
 	testhelper.RuleTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "comments_threshold.tf")
+
+	// JSON has no comments, so it is never held to the threshold.
+	jsonCases := []testhelper.FilesTestCase{
+		{
+			Name: "eos_comments_threshold_fail",
+			Files: map[string]string{
+				"main.tf.json": `{
+  "variable": {
+    "region": {
+      "default": "us-east-1"
+    }
+  }
+}
+`,
+			},
+			Want: []string{},
+		},
+	}
+
+	testhelper.FilesTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", jsonCases)
+
+	blockContent, _ := os.ReadFile("./testdata/comments_threshold_block.tf")
+
+	blockCases := []testhelper.RuleTestCase{
+		{
+			Name:    "eos_comments_threshold_block",
+			Content: string(blockContent),
+			Want: []string{
+				`Comments ratio of resource "terraform_data" "undocumented" is 20 percent (minimum threshold 25 percent)`,
+				`Comments ratio of local "bare" is 0 percent (minimum threshold 25 percent)`,
+				`Comments ratio of variable "long" is 0 percent (minimum threshold 25 percent)`,
+			},
+		},
+	}
+
+	testhelper.RuleTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", blockCases, "comments_threshold_block.tf")
 }
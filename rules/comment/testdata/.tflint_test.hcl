@@ -2,6 +2,7 @@
 # SPDX-License-Identifier: Apache-2.0
 
 rule "eos_comments" {
+  enabled = true
   block      = true
   eol        = true
   jammed = true
@@ -13,20 +14,24 @@ rule "eos_comments" {
 }
 
 rule "eos_comments_noblock" {
+  enabled = true
   block = false
 }
 
 rule "eos_comments_nojammed" {
+  enabled = true
   jammed = false
 }
 
 rule "eos_comments_nolength" {
+  enabled = true
   // length {
   //   column = 999
   // }
 }
 
 rule "eos_comments_nocolumn" {
+  enabled = true
   length {
     allow_url = true
     column = 0
@@ -34,6 +39,7 @@ rule "eos_comments_nocolumn" {
 }
 
 rule "eos_comments_nourl" {
+  enabled = true
   length {
     allow_url = false
     column = 80
@@ -41,9 +47,104 @@ rule "eos_comments_nourl" {
 }
 
 rule "eos_comments_threshold_fail" {
+  enabled = true
   threshold = 0.5
 }
 
 rule "eos_comments_threshold_good" {
+  enabled = true
   threshold = 0.1
 }
+
+rule "eos_comments_levels" {
+  enabled = true
+  eol    = "notice"
+  jammed = "error"
+  length {
+    allow_url = true
+    column    = 60
+    level     = "notice"
+  }
+}
+
+rule "eos_comments_threshold_range" {
+  enabled = true
+  threshold = 1.5
+}
+
+rule "eos_comments_bad_level" {
+  enabled = true
+  eol = "loud"
+}
+
+rule "eos_comments_typo" {
+  enabled = true
+  length {
+    colum = 100
+  }
+}
+
+rule "eos_comments_override" {
+  enabled = true
+  override {
+    files  = ["generated/*.tf"]
+    jammed = false
+  }
+}
+
+rule "eos_comments_require_doc" {
+  enabled = true
+  require_doc {}
+}
+
+rule "eos_comments_require_doc_strict" {
+  enabled = true
+  require_doc {
+    blocks    = ["module", "variable"]
+    level     = "notice"
+    min_lines = 2
+    min_words = 4
+  }
+}
+
+rule "eos_comments_require_doc_bad_block" {
+  enabled = true
+  require_doc {
+    blocks = ["resources"]
+  }
+}
+
+rule "eos_comments_require_doc_negative" {
+  enabled = true
+  require_doc {
+    min_words = -1
+  }
+}
+
+rule "eos_comments_threshold_block" {
+  enabled         = true
+  eol             = false
+  threshold       = 0.25
+  threshold_scope = "block"
+}
+
+rule "eos_comments_threshold_scope" {
+  enabled         = true
+  threshold       = 0.25
+  threshold_scope = "resource"
+}
+
+rule "eos_comments_tab_width" {
+  enabled = true
+  length {
+    column    = 40
+    tab_width = 8
+  }
+}
+
+rule "eos_comments_tab_width_zero" {
+  enabled = true
+  length {
+    tab_width = 0
+  }
+}
@@ -0,0 +1,22 @@
+# Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+# SPDX-License-Identifier: Apache-2.0
+# no-cloc
+
+/*
+  Block comments
+    keep their relative indentation.
+*/
+
+locals {
+  /**
+   * Gutters are removed.
+   *
+   * Blank lines survive.
+   */
+  value = 1
+
+  /*Jammed and on one line.*/
+  other = 2
+
+  inline = /* Moved, then converted on the next run. */ 3
+}
@@ -0,0 +1,14 @@
+# Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+# SPDX-License-Identifier: Apache-2.0
+# no-cloc
+
+resource "terraform_data" "eol" { # Moved above the block.
+  input = {
+    first  = 1 # Moved above first.
+    second = 2 //Jammed and moved.
+  }
+}
+
+locals {
+  both = 1 /* Two comments. */ # Left in place.
+}
@@ -0,0 +1,18 @@
+# Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+# SPDX-License-Identifier: Apache-2.0
+# no-cloc
+
+# Moved above the block.
+resource "terraform_data" "eol" {
+  input = {
+    # Moved above first.
+    first = 1
+    // Jammed and moved.
+    second = 2
+  }
+}
+
+locals {
+  /* Two comments. */
+  both = 1 # Left in place.
+}
@@ -0,0 +1,11 @@
+# Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+# SPDX-License-Identifier: Apache-2.0
+# no-cloc
+
+#Jammed comment
+##Jammed comment
+//Jammed comment
+///Jammed comment
+
+# Good comment
+// Good comment
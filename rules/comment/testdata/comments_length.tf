@@ -20,3 +20,7 @@ resource "foo" "bar" {
 # Short comment.
 
 # This comment is very long but it contains a url http://example.com/very/long/url/that/makes/this/line/exceed/the/limit so it should be ignored.
+
+# Accented letters take one column each, so this is within the limit in
+# columns, though not in bytes.
+# Les propriétés détaillées ci-après décrivent l’état généré et réutilisé.
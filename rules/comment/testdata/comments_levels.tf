@@ -0,0 +1,9 @@
+# Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+# SPDX-License-Identifier: Apache-2.0
+
+#FAIL: Jammed at error.
+resource "terraform_data" "example" {
+  input = "value" # FAIL: EOL at notice.
+}
+
+# FAIL: This comment runs past column forty and is reported at notice.
@@ -0,0 +1,45 @@
+# Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+# SPDX-License-Identifier: Apache-2.0
+
+# The network every tier lives in.
+module "vpc" {
+  source  = "terraform-aws-modules/vpc/aws"
+  version = "~> 5.0"
+}
+
+module "dns" {
+  source  = "terraform-aws-modules/route53/aws"
+  version = "~> 3.0"
+}
+
+# Web.
+resource "terraform_data" "web" {
+  input = "web"
+}
+
+# A doc comment separated by a blank line doesn't count.
+
+data "terraform_remote_state" "core" {
+  backend = "local"
+}
+
+locals {
+  region = "us-east-1"
+}
+
+variable "region" {}
+
+# The zone.
+variable "zone" {
+  type    = string
+  default = "a"
+}
+
+# Outputs aren't checked by default.
+output "region" {
+  value = local.region
+}
+
+output "vpc_id" {
+  value = module.vpc.vpc_id
+}
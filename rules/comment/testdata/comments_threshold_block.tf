@@ -0,0 +1,26 @@
+# Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+# SPDX-License-Identifier: Apache-2.0
+
+# The primary web server.
+resource "terraform_data" "web" {
+  input = "web"
+}
+
+resource "terraform_data" "undocumented" {
+  input            = "a"
+  triggers_replace = ["b"]
+  # One comment inside.
+}
+
+locals {
+  # The region everything runs in.
+  region = "us-east-1"
+  zone   = "a" # The primary zone.
+  bare   = "x"
+}
+
+variable "long" {
+  type        = string
+  default     = "x"
+  description = "y"
+}
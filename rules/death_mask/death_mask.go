@@ -37,41 +37,43 @@ type Rule struct {
 	// RuleName is the rule block name to load from the config file. If empty,
 	// defaults to "eos_death_mask".
 	RuleName string
-	// ConfigFile is the path to the config file. If empty, LoadRuleConfig will
-	// search CWD then $HOME for .tflint.hcl.
-	ConfigFile string
+	// overrides are the override blocks of the rule block, applied by ForFile.
+	overrides rulehelper.Overrides[deathMaskConfig]
 }
 
 // Check checks whether the rule conditions are met.
 func (r *Rule) Check(runner tflint.Runner) error {
-	// Load config using the rule name and optional config file path.
-	if err := rulehelper.LoadRuleConfig(r.Name(), &r.Config, r.ConfigFile); err != nil {
+	ignores, overrides, err := rulehelper.BeginCheck(runner, r, &r.Config)
+	if err != nil || ignores == nil {
 		return err
 	}
+	r.overrides = overrides
 
-	// Bail out early if the rule is not enabled. This will occur if the EOS
-	// plugin is enabled, but this specific rule is not.
-	if !r.Enabled() {
-		return nil
-	}
-
-	files, err := runner.GetFiles()
+	files, err := ignores.GetFiles()
 	if err != nil {
 		return err
 	}
 
 	for name, file := range files {
-		if err := r.checkDeathMask(runner, name, file); err != nil {
+		// JSON has no comments, so there is no code to comment out.
+		if !rulehelper.IsNative(file) {
+			continue
+		}
+		fileRule, enabled := rulehelper.ForFile(r, name)
+		if !enabled {
+			continue
+		}
+		if err := fileRule.checkDeathMask(ignores, name, file); err != nil {
 			return err
 		}
 	}
 
-	return nil
+	return ignores.ReportIgnores()
 }
 
 // checkDeathMask checks for commented-out code in a file.
 func (r *Rule) checkDeathMask(runner tflint.Runner, filename string, file *hcl.File) error {
-	tokens, diags := hclsyntax.LexConfig(file.Bytes, filename, hcl.InitialPos)
+	tokens, diags := rulehelper.Source(filename, file).Tokens()
 	if diags.HasErrors() {
 		return diags
 	}
@@ -178,6 +180,17 @@ func NewDeathMaskRule() *Rule {
 	return rule
 }
 
+// ForFile returns the rule with the config that applies to filename.
+func (r *Rule) ForFile(filename string) *Rule {
+	config, ok := r.overrides.Match(filename)
+	if !ok {
+		return r
+	}
+	fileRule := *r
+	fileRule.Config = config
+	return &fileRule
+}
+
 // Enabled returns whether the rule is enabled by default.
 func (r *Rule) Enabled() bool {
 	return r.Config.Enabled == nil || *r.Config.Enabled
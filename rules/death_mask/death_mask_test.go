@@ -8,7 +8,6 @@ import (
 	"os"
 	"testing"
 
-	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
 	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/testhelper"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 )
@@ -20,6 +19,7 @@ func TestDeathMask(t *testing.T) {
 
 	t.Run("Config", testDeathMaskConfig)
 	t.Run("Rule", testDeathMaskRule)
+	t.Run("Override", testDeathMaskOverride)
 }
 
 func testDeathMaskConfig(t *testing.T) {
@@ -30,17 +30,12 @@ func testDeathMaskConfig(t *testing.T) {
 		},
 		{
 			Name: "eos_death_mask_disabled",
-			Want: func() deathMaskConfig {
-				cfg := defaultDeathMaskConfig
-				cfg.Enabled = rulehelper.BoolPtr(false)
-				return cfg
-			}(),
+			Want: defaultDeathMaskConfig,
 		},
 		{
 			Name: "eos_death_mask_error",
 			Want: func() deathMaskConfig {
 				cfg := defaultDeathMaskConfig
-				cfg.Enabled = rulehelper.BoolPtr(false)
 				cfg.Level = "error"
 				return cfg
 			}(),
@@ -74,6 +69,24 @@ func testDeathMaskRule(t *testing.T) {
 	testhelper.RuleTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "death_mask.tf")
 }
 
+func testDeathMaskOverride(t *testing.T) {
+	content, _ := os.ReadFile("./testdata/death_mask.tf")
+
+	cases := []testhelper.FilesTestCase{
+		{
+			Name: "eos_death_mask_override",
+			Files: map[string]string{
+				"main.tf":        string(content),
+				"legacy/main.tf": string(content),
+			},
+			Want: FillWantMessages(4, AvoidDeathMaskMessage),
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewDeathMaskRule() }
+	testhelper.FilesTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases)
+}
+
 func FillWantMessages(count int, message string) []string {
 	want := make([]string, count)
 	for i := range count {
@@ -1,10 +1,6 @@
 # Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
 # SPDX-License-Identifier: Apache-2.0
 
-plugin "elements-of-style" {
-  enabled = true
-}
-
 rule "eos_death_mask" {
   enabled = true
 }
@@ -17,3 +13,11 @@ rule "eos_death_mask_error" {
   enabled = false
   level = "error"
 }
+
+rule "eos_death_mask_override" {
+  enabled = true
+  override {
+    files   = ["legacy/**"]
+    enabled = false
+  }
+}
@@ -23,6 +23,15 @@ type dryConfig struct {
 	Threshold int    `hclext:"threshold,optional" hcl:"threshold,optional"`
 }
 
+// Validate checks that the threshold is at least 2, as a value only repeats
+// once it appears twice.
+func (c *dryConfig) Validate() error {
+	if c.Threshold < 2 {
+		return fmt.Errorf("\"threshold\" must be at least 2, not %d", c.Threshold)
+	}
+	return nil
+}
+
 // defaultDryConfig is the default configuration for the DryRule.
 var defaultDryConfig = dryConfig{
 	Enabled:   rulehelper.BoolPtr(true),
@@ -37,50 +46,51 @@ type Rule struct {
 	// RuleName is the rule block name to load from the config file. If empty,
 	// defaults to "eos_dry".
 	RuleName string
-	// ConfigFile is the path to the config file. If empty, LoadRuleConfig will
-	// search CWD then $HOME for .tflint.hcl.
-	ConfigFile string
+	// overrides are the override blocks of the rule block, applied by ForFile.
+	overrides rulehelper.Overrides[dryConfig]
 }
 
 // Check checks whether the rule conditions are met.
 func (r *Rule) Check(runner tflint.Runner) error {
-	// Load config using the rule name and optional config file path.
-	if err := rulehelper.LoadRuleConfig(r.Name(), &r.Config, r.ConfigFile); err != nil {
+	ignores, overrides, err := rulehelper.BeginCheck(runner, r, &r.Config)
+	if err != nil || ignores == nil {
 		return err
 	}
+	r.overrides = overrides
 
-	// Bail out early if the rule is not enabled. This will occur if the EOS
-	// plugin is enabled, but this specific rule is not.
-	if !r.Enabled() {
-		return nil
-	}
-
-	// Set a floor of 2 for threshold. It doesn't make sense to use < 2, so this
-	// prevents a misconfiguration.
-	threshold := r.Config.Threshold
-	if threshold < 2 {
-		threshold = 2
-	}
-
-	files, err := runner.GetFiles()
+	allFiles, err := ignores.GetFiles()
 	if err != nil {
 		return err
 	}
 
+	// Repetitions are counted across files, so files the rule is disabled for
+	// are left out of the count altogether.
+	files := make(map[string]*hcl.File, len(allFiles))
+	for filename, file := range allFiles {
+		if _, enabled := rulehelper.ForFile(r, filename); enabled {
+			files[filename] = file
+		}
+	}
+
 	candidates := make(map[string][]hcl.Range)
 
 	for filename, file := range files {
 		if body, ok := file.Body.(*hclsyntax.Body); ok {
 			r.checkDry(body, filename, file.Bytes, candidates, false)
+		} else {
+			r.checkDryJSON(rulehelper.Source(filename, file), candidates)
 		}
 	}
 
 	for name, ranges := range candidates {
-		if len(ranges) >= threshold {
-			sort.Slice(ranges, func(i, j int) bool {
-				return ranges[i].Start.Byte < ranges[j].Start.Byte
-			})
-
+		sort.Slice(ranges, func(i, j int) bool {
+			return ranges[i].Start.Byte < ranges[j].Start.Byte
+		})
+
+		// A repetition is reported at its first occurrence, so the config of
+		// that file decides the threshold and level.
+		rule := r.ForFile(ranges[0].Filename)
+		if len(ranges) >= rule.Config.Threshold {
 			msg := fmt.Sprintf("Avoid repeating value '%s' %d times.", name, len(ranges))
 			if strings.HasPrefix(name, "[") {
 				msg = fmt.Sprintf("Avoid repeating list %d times.", len(ranges))
@@ -88,17 +98,17 @@ func (r *Rule) Check(runner tflint.Runner) error {
 				msg = fmt.Sprintf("Avoid repeating map %d times.", len(ranges))
 			}
 
-			if err := runner.EmitIssue(r, msg, ranges[0]); err != nil {
+			if err := ignores.EmitIssue(rule, msg, ranges[0]); err != nil {
 				return err
 			}
 		}
 	}
 
-	if err := r.checkDupe(runner, files, threshold); err != nil {
+	if err := r.checkDupe(ignores, files); err != nil {
 		return err
 	}
 
-	return nil
+	return ignores.ReportIgnores()
 }
 
 // checkDry recursively checks for repeated expressions in the body.
@@ -279,23 +289,26 @@ func NewDryRule() *Rule {
 }
 
 // checkDupe checks for duplicate resource and data blocks.
-func (r *Rule) checkDupe(runner tflint.Runner, files map[string]*hcl.File, threshold int) error {
+func (r *Rule) checkDupe(runner tflint.Runner, files map[string]*hcl.File) error {
 	blockHashes := make(map[string][]hcl.Range)
 
 	for filename, file := range files {
 		if body, ok := file.Body.(*hclsyntax.Body); ok {
 			r.collectBlocks(body, filename, file.Bytes, blockHashes)
+		} else {
+			r.collectBlocksJSON(rulehelper.Source(filename, file), blockHashes)
 		}
 	}
 
 	for _, ranges := range blockHashes {
-		if len(ranges) >= threshold {
-			sort.Slice(ranges, func(i, j int) bool {
-				return ranges[i].Start.Byte < ranges[j].Start.Byte
-			})
+		sort.Slice(ranges, func(i, j int) bool {
+			return ranges[i].Start.Byte < ranges[j].Start.Byte
+		})
 
+		rule := r.ForFile(ranges[0].Filename)
+		if len(ranges) >= rule.Config.Threshold {
 			msg := fmt.Sprintf("Duplicate block found %d times.", len(ranges))
-			if err := runner.EmitIssue(r, msg, ranges[0]); err != nil {
+			if err := runner.EmitIssue(rule, msg, ranges[0]); err != nil {
 				return err
 			}
 		}
@@ -344,7 +357,12 @@ func (r *Rule) hashBlock(block *hclsyntax.Block, fileBytes []byte) string {
 
 	normalized.WriteString("}")
 
-	hash := sha256.Sum256([]byte(normalized.String()))
+	return hashNormalized(normalized.String())
+}
+
+// hashNormalized hashes the normalized text of a block.
+func hashNormalized(normalized string) string {
+	hash := sha256.Sum256([]byte(normalized))
 	return fmt.Sprintf("%x", hash)
 }
 
@@ -359,6 +377,17 @@ func (r *Rule) minimizeSource(source string) string {
 	return result.String()
 }
 
+// ForFile returns the rule with the config that applies to filename.
+func (r *Rule) ForFile(filename string) *Rule {
+	config, ok := r.overrides.Match(filename)
+	if !ok {
+		return r
+	}
+	fileRule := *r
+	fileRule.Config = config
+	return &fileRule
+}
+
 // Enabled returns whether the rule is enabled by default.
 func (r *Rule) Enabled() bool {
 	return r.Config.Enabled == nil || *r.Config.Enabled
@@ -0,0 +1,121 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package dry
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
+	"github.com/hashicorp/hcl/v2"
+)
+
+// checkDryJSON collects the candidates of a JSON file. Without a schema for
+// every resource type, a JSON body can't tell nested blocks from arguments,
+// so the properties of each top-level block are all taken as arguments. A
+// string is a candidate as a whole, interpolations and all, and so are arrays
+// and non-empty objects, which also covers nested blocks.
+func (r *Rule) checkDryJSON(source *rulehelper.SourceFile, candidates map[string][]hcl.Range) {
+	fileBytes := source.File().Bytes
+	for _, block := range source.Blocks() {
+		attrs, _ := block.Body.JustAttributes()
+		for name, attr := range attrs {
+			if block.Type == "module" && name == "source" {
+				continue
+			}
+
+			rng := attr.Expr.Range()
+			source, ok := sourceText(fileBytes, rng)
+			if !ok {
+				continue
+			}
+
+			switch {
+			case strings.HasPrefix(source, `"`), strings.HasPrefix(source, "["):
+			case strings.HasPrefix(source, "{"):
+				// Skip empty maps {}.
+				if r.minimizeSource(source) == "{}" {
+					continue
+				}
+			default:
+				// Numbers, bools and null are never candidates, just as bare
+				// literals aren't in native files.
+				continue
+			}
+
+			if hasCountOrEachJSON(attr.Expr) {
+				continue
+			}
+			candidates[source] = append(candidates[source], rng)
+		}
+	}
+}
+
+// hasCountOrEachJSON reports whether a JSON expression refers to count or
+// each in any of its interpolations.
+func hasCountOrEachJSON(expr hcl.Expression) bool {
+	for _, traversal := range expr.Variables() {
+		if root := traversal.RootName(); root == "count" || root == "each" {
+			return true
+		}
+	}
+	return false
+}
+
+// collectBlocksJSON collects the resource and data blocks of a JSON file and
+// computes their hashes. A JSON block is reported at its name, as the range of
+// its body isn't exposed.
+func (r *Rule) collectBlocksJSON(source *rulehelper.SourceFile, blockHashes map[string][]hcl.Range) {
+	fileBytes := source.File().Bytes
+	for _, block := range source.Blocks() {
+		if block.Type != "resource" && block.Type != "data" {
+			continue
+		}
+		attrs, _ := block.Body.JustAttributes()
+		hash, ok := r.hashAttributes(block.Type, attrs, fileBytes)
+		if !ok {
+			continue
+		}
+		blockHashes[hash] = append(blockHashes[hash], rulehelper.DefRange(block))
+	}
+}
+
+// hashAttributes normalizes and hashes the attributes of a JSON block. ok is
+// false if the source of an attribute lies outside the file.
+func (r *Rule) hashAttributes(typ string, attrs hcl.Attributes, fileBytes []byte) (hash string, ok bool) {
+	names := make([]string, 0, len(attrs))
+	for name := range attrs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var normalized strings.Builder
+	normalized.WriteString(typ)
+	normalized.WriteString(" {")
+
+	for _, name := range names {
+		source, ok := sourceText(fileBytes, attrs[name].Range)
+		if !ok {
+			return "", false
+		}
+		normalized.WriteString(name)
+		normalized.WriteString("=")
+		normalized.WriteString(r.minimizeSource(source))
+		normalized.WriteString(";")
+	}
+
+	normalized.WriteString("}")
+
+	return hashNormalized(normalized.String()), true
+}
+
+// sourceText returns the text of fileBytes that rng covers, and false if rng
+// doesn't lie within it.
+func sourceText(fileBytes []byte, rng hcl.Range) (string, bool) {
+	if rng.Start.Byte < 0 || rng.Start.Byte > rng.End.Byte || rng.End.Byte > len(fileBytes) {
+		return "", false
+	}
+	return string(fileBytes[rng.Start.Byte:rng.End.Byte]), true
+}
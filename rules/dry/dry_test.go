@@ -8,8 +8,9 @@ import (
 	"os"
 	"testing"
 
-	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
 	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/testhelper"
+
+	"github.com/hashicorp/hcl/v2"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 )
 
@@ -19,6 +20,9 @@ func TestDry(t *testing.T) {
 	}
 
 	t.Run("Config", testDryConfig)
+	t.Run("ConfigErrors", testDryConfigErrors)
+	t.Run("JSON", testDryJSON)
+	t.Run("Override", testDryOverride)
 	t.Run("Rule", testDryRule)
 }
 
@@ -30,11 +34,7 @@ func testDryConfig(t *testing.T) {
 		},
 		{
 			Name: "eos_dry_disabled",
-			Want: func() dryConfig {
-				cfg := defaultDryConfig
-				cfg.Enabled = rulehelper.BoolPtr(false)
-				return cfg
-			}(),
+			Want: defaultDryConfig,
 		},
 		{
 			Name: "eos_dry_threshold",
@@ -50,6 +50,122 @@ func testDryConfig(t *testing.T) {
 
 }
 
+func testDryConfigErrors(t *testing.T) {
+	cases := []testhelper.ConfigErrorTestCase{
+		{
+			Name: "eos_dry_info",
+			Want: `rule "eos_dry_info": "level" must be one of notice, warning, error, not "info"`,
+		},
+		{
+			Name: "eos_dry_low_threshold",
+			Want: `rule "eos_dry_low_threshold": "threshold" must be at least 2, not 1`,
+		},
+		{
+			Name: "eos_dry_typo",
+			Want: `rule "eos_dry_typo": unknown option "treshold" at testdata/.tflint_test.hcl:29,3-11. Did you mean "threshold"? Valid options: enabled, level, threshold, override { files, ... }.`,
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewDryRule() }
+	testhelper.ConfigErrorTestRunner(t, ruleFactory, cases)
+}
+
+func testDryJSON(t *testing.T) {
+	cases := []testhelper.FilesTestCase{
+		{
+			Name: "eos_dry",
+			Files: map[string]string{
+				"main.tf.json": `{
+  "resource": {
+    "aws_instance": {
+      "web": {
+        "ami": "ami-123",
+        "tags": {"team": "core"},
+        "name": "web-${count.index}"
+      },
+      "api": {
+        "ami": "ami-123",
+        "tags": {"team": "core"},
+        "name": "api-${count.index}"
+      }
+    },
+    "aws_s3_bucket": {
+      "logs": {
+        "acl": "private",
+        "tags": {}
+      }
+    }
+  },
+  "data": {
+    "aws_ami": {
+      "ubuntu": {"most_recent": true},
+      "debian": {"most_recent": true}
+    }
+  },
+  "module": {
+    "one": {
+      "source": "./modules/shared",
+      "zones": ["a", "b"]
+    },
+    "two": {
+      "source": "./modules/shared",
+      "zones": ["a", "b"]
+    }
+  }
+}
+`,
+				"main.tf": `locals {
+  acl = "private"
+}
+`,
+			},
+			Want: []string{
+				`Avoid repeating value '"ami-123"' 2 times.`,
+				`Avoid repeating value '"private"' 2 times.`,
+				"Avoid repeating map 2 times.",
+				"Avoid repeating list 2 times.",
+				"Duplicate block found 2 times.",
+			},
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewDryRule() }
+	testhelper.FilesTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases)
+}
+
+func testDryOverride(t *testing.T) {
+	cases := []testhelper.FilesTestCase{
+		{
+			Name: "eos_dry_override",
+			Files: map[string]string{
+				"main.tf": `locals {
+  a = "alpha"
+  d = "delta"
+  e = "delta"
+}
+`,
+				"examples/main.tf": `locals {
+  a = "alpha"
+  b = "beta"
+  c = "beta"
+}
+`,
+				"legacy/main.tf": `locals {
+  a = "gamma"
+  b = "gamma"
+}
+`,
+			},
+			Want: []string{
+				`Avoid repeating value '"delta"' 2 times.`,
+			},
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewDryRule() }
+	testhelper.FilesTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases)
+}
+
 func testDryRule(t *testing.T) {
 	cases := []testhelper.RuleTestCase{
 		{
@@ -84,3 +200,13 @@ func testDryRule(t *testing.T) {
 	ruleFactory := func() tflint.Rule { return NewDryRule() }
 	testhelper.RuleTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "dry_test.tf")
 }
+
+func TestDryHashAttributesOutOfRange(t *testing.T) {
+	fileBytes := []byte(`{"resource": {}}`)
+	attrs := hcl.Attributes{
+		"name": {Name: "name", Range: hcl.Range{Start: hcl.Pos{Byte: 10}, End: hcl.Pos{Byte: 40}}},
+	}
+	if _, ok := NewDryRule().hashAttributes("resource", attrs, fileBytes); ok {
+		t.Error("Expected an attribute past the end of the file to fail the hash")
+	}
+}
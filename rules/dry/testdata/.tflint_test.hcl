@@ -10,9 +10,33 @@ rule "eos_dry_disabled" {
 }
 
 rule "eos_dry_info" {
+  enabled = true
   level = "info"
 }
 
 rule "eos_dry_threshold" {
+  enabled = true
   threshold = 5
 }
+
+rule "eos_dry_low_threshold" {
+  enabled = true
+  threshold = 1
+}
+
+rule "eos_dry_typo" {
+  enabled = true
+  treshold = 3
+}
+
+rule "eos_dry_override" {
+  enabled = true
+  override {
+    files   = ["examples/**"]
+    enabled = false
+  }
+  override {
+    files     = ["legacy/**"]
+    threshold = 3
+  }
+}
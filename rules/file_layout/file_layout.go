@@ -0,0 +1,192 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package filelayout
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// MisplacedMessage is emitted for a block outside the files its type belongs
+// in.
+const MisplacedMessage = "%s should be in %s, not %s."
+
+// defaultFiles maps each block type to the files it belongs in. Types not
+// listed may be in any file.
+var defaultFiles = map[string][]string{
+	"output":    {"outputs.tf"},
+	"provider":  {"providers.tf"},
+	"terraform": {"terraform.tf", "versions.tf"},
+	"variable":  {"variables.tf"},
+}
+
+// layoutBlocks are the top-level blocks to check, i.e. all of them. Which
+// are actually checked depends on the layout of the rule for the file.
+var layoutBlocks = func() []rulehelper.BlockDef {
+	var defs []rulehelper.BlockDef
+	for _, block := range rulehelper.TerraformSchema.Blocks {
+		defs = append(defs, rulehelper.BlockDef{Typ: block.Type})
+	}
+	return defs
+}()
+
+// fileLayoutConfig represents the configuration for the FileLayoutRule.
+type fileLayoutConfig struct {
+	// Components are glob patterns of the files that hold one logical
+	// component, e.g. "iam.tf", with its variables and outputs alongside
+	// its resources. Blocks in them aren't checked.
+	Components []string `hclext:"components,optional" hcl:"components,optional"`
+	Enabled    *bool    `hclext:"enabled,optional" hcl:"enabled,optional"`
+	Level      string   `hclext:"level,optional" hcl:"level,optional"`
+	// Layout maps block types to the files they belong in. Its entries
+	// replace those of the defaults, and an empty list lets the type be in
+	// any file.
+	Layout map[string][]string `hclext:"layout,optional" hcl:"layout,optional"`
+}
+
+// Validate checks the values of the config.
+func (c *fileLayoutConfig) Validate() error {
+	var types []string
+	for _, def := range layoutBlocks {
+		types = append(types, def.Typ)
+	}
+	for typ := range c.Layout {
+		if !slices.Contains(types, typ) {
+			return fmt.Errorf("\"layout\" keys must be block types, one of %s, not %q", strings.Join(types, ", "), typ)
+		}
+	}
+	for _, pattern := range c.Components {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return fmt.Errorf("\"components\" has a malformed pattern %q", pattern)
+		}
+	}
+	return nil
+}
+
+// defaultFileLayoutConfig is the default configuration for the
+// FileLayoutRule. The layout defaults to defaultFiles.
+var defaultFileLayoutConfig = fileLayoutConfig{
+	Enabled: rulehelper.BoolPtr(true),
+	Level:   "warning",
+}
+
+// Rule checks that blocks are in the files their types belong in.
+type Rule struct {
+	tflint.DefaultRule
+	Config fileLayoutConfig
+	// RuleName is the rule block name to load from the config file. If empty,
+	// defaults to "eos_file_layout".
+	RuleName string
+	// overrides are the override blocks of the rule block, applied by ForFile.
+	overrides rulehelper.Overrides[fileLayoutConfig]
+}
+
+// Check checks whether the rule conditions are met.
+func (r *Rule) Check(runner tflint.Runner) error {
+	ignores, overrides, err := rulehelper.BeginCheck(runner, r, &r.Config)
+	if err != nil || ignores == nil {
+		return err
+	}
+	r.overrides = overrides
+
+	if err := rulehelper.WalkBlockContents(ignores, layoutBlocks, r, checkLayout); err != nil {
+		return err
+	}
+	return ignores.ReportIgnores()
+}
+
+// checkLayout reports a block outside the files its type belongs in.
+func checkLayout(runner tflint.Runner, r *Rule, block *hcl.Block) {
+	files := r.files(block.Type)
+	if len(files) == 0 {
+		return
+	}
+
+	// A JSON file belongs where the native file of the same name would.
+	base := filepath.Base(block.DefRange.Filename)
+	name := strings.TrimSuffix(base, ".json")
+	if slices.Contains(files, name) || r.isComponent(name) {
+		return
+	}
+
+	message := fmt.Sprintf(MisplacedMessage, rulehelper.DescribeBlock(block), joinFiles(files), base)
+	rulehelper.EmitIssue(runner, r, "", "", message, rulehelper.DefRange(block))
+}
+
+// files returns the files blocks of typ belong in, or nil if they may be in
+// any file.
+func (r *Rule) files(typ string) []string {
+	if files, ok := r.Config.Layout[typ]; ok {
+		return files
+	}
+	return defaultFiles[typ]
+}
+
+// isComponent reports whether the file named name holds one logical
+// component.
+func (r *Rule) isComponent(name string) bool {
+	for _, pattern := range r.Config.Components {
+		if ok, _ := filepath.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// joinFiles lists files in prose, e.g. "terraform.tf or versions.tf".
+func joinFiles(files []string) string {
+	if len(files) == 1 {
+		return files[0]
+	}
+	return strings.Join(files[:len(files)-1], ", ") + " or " + files[len(files)-1]
+}
+
+// NewFileLayoutRule returns a new rule.
+func NewFileLayoutRule() *Rule {
+	rule := &Rule{}
+	rule.Config = defaultFileLayoutConfig
+	return rule
+}
+
+// ForFile returns the rule with the config that applies to filename.
+func (r *Rule) ForFile(filename string) *Rule {
+	config, ok := r.overrides.Match(filename)
+	if !ok {
+		return r
+	}
+	fileRule := *r
+	fileRule.Config = config
+	return &fileRule
+}
+
+// Enabled returns whether the rule is enabled by default.
+func (r *Rule) Enabled() bool {
+	return r.Config.Enabled == nil || *r.Config.Enabled
+}
+
+// Link returns the rule reference link.
+func (r *Rule) Link() string {
+	return "https://github.com/tfctl/tflint-ruleset-elements-of-style/blob/main/docs/rules/eos_file_layout.md"
+}
+
+// Name returns the rule name.
+func (r *Rule) Name() string {
+	if r.RuleName != "" {
+		return r.RuleName
+	}
+
+	return "eos_file_layout"
+}
+
+// Severity returns the rule severity.
+func (r *Rule) Severity() tflint.Severity {
+	return rulehelper.ToSeverity(r.Config.Level)
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package filelayout
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/testhelper"
+)
+
+func TestFileLayout(t *testing.T) {
+	if !flag.Parsed() {
+		flag.Parse()
+	}
+
+	t.Run("Config", testFileLayoutConfig)
+	t.Run("ConfigErrors", testFileLayoutConfigErrors)
+
+	t.Run("Files", testFileLayoutFiles)
+}
+
+func testFileLayoutConfig(t *testing.T) {
+	cases := []testhelper.ConfigTestCase{
+		{
+			Name: "eos_file_layout",
+			Want: defaultFileLayoutConfig,
+		},
+		{
+			Name: "eos_file_layout_custom",
+			Want: func() fileLayoutConfig {
+				cfg := defaultFileLayoutConfig
+				cfg.Level = "notice"
+				cfg.Components = []string{"network*.tf"}
+				cfg.Layout = map[string][]string{
+					"locals":   {"locals.tf"},
+					"variable": {"inputs.tf"},
+					"provider": {},
+				}
+				return cfg
+			}(),
+		},
+	}
+
+	testhelper.ConfigTestRunner(t, defaultFileLayoutConfig, cases)
+}
+
+func testFileLayoutConfigErrors(t *testing.T) {
+	cases := []testhelper.ConfigErrorTestCase{
+		{
+			Name: "eos_file_layout_bad_type",
+			Want: `rule "eos_file_layout_bad_type": "layout" keys must be block types, one of terraform, provider, variable, locals, output, module, resource, data, ephemeral, check, moved, import, removed, not "outputs"`,
+		},
+		{
+			Name: "eos_file_layout_bad_component",
+			Want: `rule "eos_file_layout_bad_component": "components" has a malformed pattern "[iam.tf"`,
+		},
+		{
+			Name: "eos_file_layout_typo",
+			Want: `rule "eos_file_layout_typo": unknown option "component" at testdata/.tflint_test.hcl:33,3-12. Did you mean "components"? Valid options: components, enabled, layout, level, override { files, ... }.`,
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewFileLayoutRule() }
+	testhelper.ConfigErrorTestRunner(t, ruleFactory, cases)
+}
+
+func testFileLayoutFiles(t *testing.T) {
+	files := map[string]string{
+		"main.tf": `terraform {
+  required_version = ">= 1.5"
+}
+
+provider "aws" {
+  region = var.region
+}
+
+locals {
+  name = "logs"
+}
+
+resource "aws_s3_bucket" "logs" {
+  bucket = local.name
+}
+
+output "bucket_arn" {
+  description = "The ARN of the bucket."
+  value       = aws_s3_bucket.logs.arn
+}
+`,
+		"variables.tf": `variable "region" {
+  description = "The region to deploy to."
+  type        = string
+}
+
+# eos-ignore: file_layout -- kept with the other variables for now
+output "region" {
+  description = "The region deployed to."
+  value       = var.region
+}
+`,
+		"network.tf": `variable "cidr" {
+  description = "The CIDR block of the VPC."
+  type        = string
+}
+
+resource "aws_vpc" "main" {
+  cidr_block = var.cidr
+}
+`,
+		"outputs.tf.json": `{
+  "output": {
+    "vpc_id": {
+      "value": "${aws_vpc.main.id}"
+    }
+  },
+  "variable": {
+    "tags": {
+      "type": "map(string)"
+    }
+  }
+}
+`,
+		"versions.tf": `terraform {
+  required_providers {
+    aws = {
+      source = "hashicorp/aws"
+    }
+  }
+}
+`,
+	}
+
+	cases := []testhelper.FilesTestCase{
+		{
+			Name:  "eos_file_layout",
+			Files: files,
+			Want: []string{
+				fmt.Sprintf(MisplacedMessage, "Terraform block", "terraform.tf or versions.tf", "main.tf"),
+				fmt.Sprintf(MisplacedMessage, "Provider 'aws'", "providers.tf", "main.tf"),
+				fmt.Sprintf(MisplacedMessage, "Output 'bucket_arn'", "outputs.tf", "main.tf"),
+				fmt.Sprintf(MisplacedMessage, "Variable 'cidr'", "variables.tf", "network.tf"),
+				fmt.Sprintf(MisplacedMessage, "Variable 'tags'", "variables.tf", "outputs.tf.json"),
+			},
+		},
+		{
+			Name:  "eos_file_layout_custom",
+			Files: files,
+			Want: []string{
+				fmt.Sprintf(MisplacedMessage, "Terraform block", "terraform.tf or versions.tf", "main.tf"),
+				fmt.Sprintf(MisplacedMessage, "Locals block", "locals.tf", "main.tf"),
+				fmt.Sprintf(MisplacedMessage, "Output 'bucket_arn'", "outputs.tf", "main.tf"),
+				fmt.Sprintf(MisplacedMessage, "Variable 'region'", "inputs.tf", "variables.tf"),
+				// The annotation names file_layout, not this rule.
+				fmt.Sprintf(MisplacedMessage, "Output 'region'", "outputs.tf", "variables.tf"),
+				fmt.Sprintf(MisplacedMessage, "Variable 'tags'", "inputs.tf", "outputs.tf.json"),
+			},
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewFileLayoutRule() }
+	testhelper.FilesTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases)
+}
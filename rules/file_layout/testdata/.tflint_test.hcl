@@ -0,0 +1,34 @@
+# Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+# SPDX-License-Identifier: Apache-2.0
+
+rule "eos_file_layout" {
+  enabled = true
+}
+
+rule "eos_file_layout_custom" {
+  enabled    = true
+  level      = "notice"
+  components = ["network*.tf"]
+  layout     = {
+    locals   = ["locals.tf"]
+    variable = ["inputs.tf"]
+    provider = []
+  }
+}
+
+rule "eos_file_layout_bad_type" {
+  enabled = true
+  layout  = {
+    outputs = ["outputs.tf"]
+  }
+}
+
+rule "eos_file_layout_bad_component" {
+  enabled    = true
+  components = ["[iam.tf"]
+}
+
+rule "eos_file_layout_typo" {
+  enabled   = true
+  component = ["iam.tf"]
+}
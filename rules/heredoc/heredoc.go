@@ -21,15 +21,21 @@ var heredocPattern = regexp.MustCompile(`<<(-?)([a-zA-Z0-9]+)\s*$`)
 
 // heredocConfig represents the configuration for the HeredocRule.
 type heredocConfig struct {
-	Enabled *bool  `hclext:"enabled,optional" hcl:"enabled,optional"`
-	EOF     bool   `hclext:"EOF,optional" hcl:"EOF,optional"`
-	Level   string `hclext:"level,optional" hcl:"level,optional"`
+	Enabled *bool `hclext:"enabled,optional" hcl:"enabled,optional"`
+	// Delimiter replaces 'EOF' when the EOF check is fixed with --fix.
+	Delimiter string              `hclext:"delimiter,optional" hcl:"delimiter,optional"`
+	EOF       rulehelper.SubCheck `hclext:"EOF,optional" hcl:"EOF,optional"`
+	Level     string              `hclext:"level,optional" hcl:"level,optional"`
+	// Standard enables the check for standard (<<) heredocs.
+	Standard rulehelper.SubCheck `hclext:"standard,optional" hcl:"standard,optional"`
 }
 
 // defaultHeredocConfig is the default configuration for the HeredocRule.
 var defaultHeredocConfig = heredocConfig{
-	EOF:   true,
-	Level: "warning",
+	Delimiter: "TEXT",
+	EOF:       "true",
+	Level:     "warning",
+	Standard:  "true",
 }
 
 // Rule checks for standard heredoc usage.
@@ -39,27 +45,26 @@ type Rule struct {
 	// RuleName is the rule block name to load from the config file. If empty,
 	// defaults to "eos_heredoc".
 	RuleName string
-	// ConfigFile is the path to the config file. If empty, LoadRuleConfig will
-	// search CWD then $HOME for .tflint.hcl.
-	ConfigFile string
+	// overrides are the override blocks of the rule block, applied by ForFile.
+	overrides rulehelper.Overrides[heredocConfig]
 }
 
 // Check checks whether the rule conditions are met.
 func (r *Rule) Check(runner tflint.Runner) error {
-	// Load config using the rule name and optional config file path.
-	if err := rulehelper.LoadRuleConfig(r.Name(), &r.Config, r.ConfigFile); err != nil {
+	ignores, overrides, err := rulehelper.BeginCheck(runner, r, &r.Config)
+	if err != nil || ignores == nil {
 		return err
 	}
+	r.overrides = overrides
 
-	// Bail out early if the rule is not enabled. This will occur if the EOS
-	// plugin is enabled, but this specific rule is not.
-	if !r.Enabled() {
-		return nil
+	if err := rulehelper.WalkTokens(ignores, r, checkHeredocToken); err != nil {
+		return err
 	}
-	return rulehelper.WalkTokens(runner, r, checkHeredocToken)
+	return ignores.ReportIgnores()
 }
 
-// checkHeredocToken checks for heredoc style violations in a token.
+// checkHeredocToken checks for heredoc style violations in a token. Both
+// issues carry a fix that rewrites the heredoc in place.
 func checkHeredocToken(runner tflint.Runner, r *Rule, token hclsyntax.Token) {
 	if token.Type == hclsyntax.TokenOHeredoc {
 		text := string(token.Bytes)
@@ -67,18 +72,35 @@ func checkHeredocToken(runner tflint.Runner, r *Rule, token hclsyntax.Token) {
 			indentMarker := matches[1]
 			heredocLabel := matches[2]
 
-			if indentMarker == "" {
+			// The layout is only needed by the fixes, which fall back to a plain
+			// issue if the heredoc can't be located.
+			var layout *heredocLayout
+			if file, err := runner.GetFile(token.Range.Filename); err == nil && file != nil {
+				layout = parseHeredoc(file.Bytes, token, indentMarker, heredocLabel)
+			}
+
+			if indentMarker == "" && r.Config.Standard.Enabled() {
 				message := AvoidStandardHeredocMessage
-				if err := runner.EmitIssue(r, message, token.Range); err != nil {
+				if err := rulehelper.SubRule(runner, "standard").EmitIssueWithFix(rulehelper.WithLevel(r, r.Config.Standard.Level()), message, token.Range, func(f tflint.Fixer) error {
+					if layout == nil {
+						return tflint.ErrFixNotSupported
+					}
+					return layout.fixStandard(f)
+				}); err != nil {
 					logger.Error(err.Error())
 				}
 			}
 
-			if r.Config.EOF {
+			if r.Config.EOF.Enabled() {
 				// Also check for EOF usage.
 				if heredocLabel == "EOF" {
 					eofMessage := AvoidEOFHeredocMessage
-					if err := runner.EmitIssue(r, eofMessage, token.Range); err != nil {
+					if err := rulehelper.SubRule(runner, "eof").EmitIssueWithFix(rulehelper.WithLevel(r, r.Config.EOF.Level()), eofMessage, token.Range, func(f tflint.Fixer) error {
+						if layout == nil {
+							return tflint.ErrFixNotSupported
+						}
+						return layout.fixDelimiter(f, r.Config.Delimiter)
+					}); err != nil {
 						logger.Error(err.Error())
 					}
 				}
@@ -94,6 +116,17 @@ func NewHeredocRule() *Rule {
 	return rule
 }
 
+// ForFile returns the rule with the config that applies to filename.
+func (r *Rule) ForFile(filename string) *Rule {
+	config, ok := r.overrides.Match(filename)
+	if !ok {
+		return r
+	}
+	fileRule := *r
+	fileRule.Config = config
+	return &fileRule
+}
+
 // Enabled returns whether the rule is enabled by default.
 func (r *Rule) Enabled() bool {
 	return r.Config.Enabled == nil || *r.Config.Enabled
@@ -0,0 +1,161 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package heredoc
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// heredocIndent is the indentation added to the body of a heredoc relative to
+// the line holding the opening marker.
+const heredocIndent = "  "
+
+// delimiterPattern matches the delimiters that heredocPattern recognizes.
+var delimiterPattern = regexp.MustCompile(`^[a-zA-Z0-9]+$`)
+
+// heredocLine is a single line of a heredoc body.
+type heredocLine struct {
+	start hcl.Pos
+	text  string
+}
+
+// heredocLayout describes the source layout of a single heredoc so that it can
+// be rewritten by the fixes.
+type heredocLayout struct {
+	filename string
+	// label is the range of the delimiter in the opening marker.
+	label hcl.Range
+	// indent is the leading whitespace of the line holding the opening marker.
+	indent string
+	lines  []heredocLine
+	// closeLine is the start of the line holding the closing delimiter and
+	// closeLabel is the range of the delimiter itself.
+	closeLine  hcl.Pos
+	closeLabel hcl.Range
+}
+
+// parseHeredoc locates the body and closing delimiter of the heredoc opened by
+// token. It returns nil if the heredoc cannot be located in the source.
+func parseHeredoc(src []byte, token hclsyntax.Token, indentMarker string, label string) *heredocLayout {
+	open := token.Range
+	if open.End.Byte > len(src) {
+		return nil
+	}
+
+	labelStart := open.Start
+	labelStart.Byte += 2 + len(indentMarker)
+	labelStart.Column += 2 + len(indentMarker)
+	labelEnd := labelStart
+	labelEnd.Byte += len(label)
+	labelEnd.Column += len(label)
+
+	layout := &heredocLayout{
+		filename: open.Filename,
+		label:    hcl.Range{Filename: open.Filename, Start: labelStart, End: labelEnd},
+	}
+
+	lineStart := bytes.LastIndexByte(src[:open.Start.Byte], '\n') + 1
+	line := src[lineStart:open.Start.Byte]
+	layout.indent = string(line[:len(line)-len(bytes.TrimLeft(line, " \t"))])
+
+	offset := open.End.Byte
+	lineNumber := open.Start.Line + 1
+	for offset < len(src) {
+		end := bytes.IndexByte(src[offset:], '\n')
+		if end < 0 {
+			end = len(src)
+		} else {
+			end += offset
+		}
+
+		text := strings.TrimRight(string(src[offset:end]), "\r")
+		start := hcl.Pos{Line: lineNumber, Column: 1, Byte: offset}
+		trimmed := strings.TrimLeft(text, " \t")
+		if trimmed == label {
+			ws := len(text) - len(trimmed)
+			layout.closeLine = start
+			layout.closeLabel = hcl.Range{
+				Filename: open.Filename,
+				Start:    hcl.Pos{Line: lineNumber, Column: ws + 1, Byte: offset + ws},
+				End:      hcl.Pos{Line: lineNumber, Column: ws + len(label) + 1, Byte: offset + ws + len(label)},
+			}
+			return layout
+		}
+
+		layout.lines = append(layout.lines, heredocLine{start: start, text: text})
+		offset = end + 1
+		lineNumber++
+	}
+
+	return nil
+}
+
+// minIndent returns the smallest number of leading whitespace characters on
+// any non-blank line of the body. Blank lines are ignored by HCL when it
+// flushes an indented heredoc, so they are ignored here too.
+func (h *heredocLayout) minIndent() int {
+	minimum := -1
+	for _, line := range h.lines {
+		trimmed := strings.TrimLeft(line.text, " \t")
+		if trimmed == "" {
+			continue
+		}
+		if n := len(line.text) - len(trimmed); minimum < 0 || n < minimum {
+			minimum = n
+		}
+	}
+	return max(minimum, 0)
+}
+
+// fixStandard rewrites a standard heredoc (<<) as an indented heredoc (<<-)
+// and indents its body one level deeper than the opening line. The closing
+// delimiter is aligned with the opening line.
+func (h *heredocLayout) fixStandard(f tflint.Fixer) error {
+	// An indented heredoc strips the common leading whitespace from its body.
+	// If the body is already indented, converting it would change its value.
+	if h.minIndent() > 0 {
+		return tflint.ErrFixNotSupported
+	}
+
+	if err := f.InsertTextBefore(h.label, "-"); err != nil {
+		return err
+	}
+
+	for _, line := range h.lines {
+		if strings.TrimSpace(line.text) == "" {
+			continue
+		}
+		rng := hcl.Range{Filename: h.filename, Start: line.start, End: line.start}
+		if err := f.InsertTextBefore(rng, h.indent+heredocIndent); err != nil {
+			return err
+		}
+	}
+
+	return f.ReplaceText(hcl.Range{Filename: h.filename, Start: h.closeLine, End: h.closeLabel.Start}, h.indent)
+}
+
+// fixDelimiter renames the delimiter on both the opening and closing lines.
+func (h *heredocLayout) fixDelimiter(f tflint.Fixer, delimiter string) error {
+	if !delimiterPattern.MatchString(delimiter) || delimiter == "EOF" {
+		return tflint.ErrFixNotSupported
+	}
+
+	// A body line that matches the new delimiter would close the heredoc early.
+	for _, line := range h.lines {
+		if strings.TrimLeft(line.text, " \t") == delimiter {
+			return tflint.ErrFixNotSupported
+		}
+	}
+
+	if err := f.ReplaceText(h.label, delimiter); err != nil {
+		return err
+	}
+	return f.ReplaceText(h.closeLabel, delimiter)
+}
@@ -6,9 +6,9 @@ package heredoc
 import (
 	"flag"
 	"os"
+	"strings"
 	"testing"
 
-	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
 	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/testhelper"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 )
@@ -20,6 +20,7 @@ func TestHeredoc(t *testing.T) {
 
 	t.Run("Config", testHeredocConfig)
 	t.Run("Rule", testHeredocRule)
+	t.Run("Fix", testHeredocFix)
 }
 
 func testHeredocConfig(t *testing.T) {
@@ -30,17 +31,21 @@ func testHeredocConfig(t *testing.T) {
 		},
 		{
 			Name: "eos_heredoc_disabled",
+			Want: defaultHeredocConfig,
+		},
+		{
+			Name: "eos_heredoc_no_eof",
 			Want: func() heredocConfig {
 				cfg := defaultHeredocConfig
-				cfg.Enabled = rulehelper.BoolPtr(false)
+				cfg.EOF = "false"
 				return cfg
 			}(),
 		},
 		{
-			Name: "eos_heredoc_no_eof",
+			Name: "eos_heredoc_delimiter",
 			Want: func() heredocConfig {
 				cfg := defaultHeredocConfig
-				cfg.EOF = false
+				cfg.Delimiter = "SHELL"
 				return cfg
 			}(),
 		},
@@ -67,3 +72,31 @@ func testHeredocRule(t *testing.T) {
 	ruleFactory := func() tflint.Rule { return NewHeredocRule() }
 	testhelper.RuleTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "heredoc_test.tf")
 }
+
+func testHeredocFix(t *testing.T) {
+	readFile := func(name string) string {
+		content, _ := os.ReadFile("./testdata/" + name)
+		return string(content)
+	}
+
+	cases := []testhelper.FixTestCase{
+		{
+			Name:    "eos_heredoc",
+			Content: readFile("heredoc_fix.tf"),
+			Want:    readFile("heredoc_fix_fixed.tf"),
+		},
+		{
+			Name:    "eos_heredoc_delimiter",
+			Content: readFile("heredoc_fix_script.tf"),
+			Want:    readFile("heredoc_fix_script_fixed.tf"),
+		},
+		{
+			Name:    "eos_heredoc_no_eof",
+			Content: readFile("heredoc_fix_script.tf"),
+			Want:    strings.ReplaceAll(readFile("heredoc_fix_script_fixed.tf"), "SHELL", "EOF"),
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewHeredocRule() }
+	testhelper.FixTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "heredoc_fix.tf")
+}
@@ -2,6 +2,7 @@
 # SPDX-License-Identifier: Apache-2.0
 
 rule "eos_heredoc" {
+  enabled = true
   EOF = true
 }
 
@@ -10,5 +11,11 @@ rule "eos_heredoc_disabled" {
 }
 
 rule "eos_heredoc_no_eof" {
+  enabled = true
   EOF = false
 }
+
+rule "eos_heredoc_delimiter" {
+  enabled = true
+  delimiter = "SHELL"
+}
@@ -0,0 +1,24 @@
+# Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+# SPDX-License-Identifier: Apache-2.0
+
+locals {
+  standard = <<-TEXT
+    #!/bin/bash
+    echo "${var.greeting}"
+
+      echo "indented"
+  TEXT
+
+  indented = <<-TEXT
+    Already indented.
+  TEXT
+
+  named = <<-SHELL
+    echo "hello"
+  SHELL
+
+  # The body is already indented, so converting to <<- would change its value.
+  preserved = <<JSON
+  {"a": 1}
+JSON
+}
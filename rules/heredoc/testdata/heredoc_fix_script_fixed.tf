@@ -0,0 +1,8 @@
+# Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+# SPDX-License-Identifier: Apache-2.0
+
+resource "terraform_data" "script" {
+  input = <<-SHELL
+    echo "hello"
+  SHELL
+}
@@ -43,25 +43,22 @@ type Rule struct {
 	// RuleName is the rule block name to load from the config file. If empty,
 	// defaults to "eos_hungarian".
 	RuleName string
-	// ConfigFile is the path to the config file. If empty, LoadRuleConfig will
-	// search CWD then $HOME for .tflint.hcl.
-	ConfigFile string
+	// overrides are the override blocks of the rule block, applied by ForFile.
+	overrides rulehelper.Overrides[hungarianConfig]
 }
 
 // Check checks whether the rule conditions are met.
 func (r *Rule) Check(runner tflint.Runner) error {
-	// Load config using the rule name and optional config file path.
-	if err := rulehelper.LoadRuleConfig(r.Name(), &r.Config, r.ConfigFile); err != nil {
+	ignores, overrides, err := rulehelper.BeginCheck(runner, r, &r.Config)
+	if err != nil || ignores == nil {
 		return err
 	}
+	r.overrides = overrides
 
-	// Bail out early if the rule is not enabled. This will occur if the EOS
-	// plugin is enabled, but this specific rule is not.
-	if !r.Enabled() {
-		return nil
+	if err := rulehelper.WalkBlocks(ignores, rulehelper.AllLintableBlocks, r, checkForHungarian); err != nil {
+		return err
 	}
-
-	return rulehelper.WalkBlocks(runner, rulehelper.AllLintableBlocks, r, checkForHungarian)
+	return ignores.ReportIgnores()
 }
 
 // checkForHungarian checks if the name uses Hungarian notation.
@@ -90,6 +87,17 @@ func NewHungarianRule() *Rule {
 	return rule
 }
 
+// ForFile returns the rule with the config that applies to filename.
+func (r *Rule) ForFile(filename string) *Rule {
+	config, ok := r.overrides.Match(filename)
+	if !ok {
+		return r
+	}
+	fileRule := *r
+	fileRule.Config = config
+	return &fileRule
+}
+
 // Enabled returns whether the rule is enabled by default.
 func (r *Rule) Enabled() bool {
 	return r.Config.Enabled == nil || *r.Config.Enabled
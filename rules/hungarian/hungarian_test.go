@@ -9,7 +9,6 @@ import (
 	"os"
 	"testing"
 
-	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
 	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/testhelper"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 )
@@ -20,6 +19,7 @@ func TestHungarian(t *testing.T) {
 	}
 
 	t.Run("Config", testHungarianConfig)
+	t.Run("JSON", testHungarianJSON)
 	t.Run("Rule", testHungarianRule)
 }
 
@@ -32,11 +32,7 @@ func testHungarianConfig(t *testing.T) {
 		},
 		{
 			Name: "eos_hungarian_disabled",
-			Want: func() hungarianConfig {
-				cfg := defaultHungarianConfig
-				cfg.Enabled = rulehelper.BoolPtr(false)
-				return cfg
-			}(),
+			Want: defaultHungarianConfig,
 		},
 		{
 			Name: "eos_hungarian_custom_tags",
@@ -86,6 +82,34 @@ func testHungarianRule(t *testing.T) {
 	testhelper.RuleTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "hungarian_test.tf")
 }
 
+func testHungarianJSON(t *testing.T) {
+	cases := []testhelper.RuleTestCase{
+		{
+			Name: "eos_hungarian",
+			Content: `{
+  "variable": {
+    "str_region": {
+      "type": "string"
+    }
+  },
+  "output": {
+    "subnet_lst": {
+      "value": "${var.subnets}"
+    }
+  }
+}
+`,
+			Want: []string{
+				makeMessage("str_region", "str"),
+				makeMessage("subnet_lst", "lst"),
+			},
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewHungarianRule() }
+	testhelper.RuleTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "hungarian_test.tf.json")
+}
+
 func makeMessage(name string, key string) string {
 	return fmt.Sprintf("Avoid Hungarian notation '%s' in '%s'.", key, name)
 }
@@ -2,10 +2,12 @@
 # SPDX-License-Identifier: Apache-2.0
 
 rule "eos_hungarian" {
+  enabled = true
   tags = ["str", "int", "num", "bool", "list", "lst", "set", "map", "arr", "array"]
 }
 
 rule "eos_hungarian_custom_tags" {
+  enabled = true
   tags = ["foo", "bar"]
 }
 
@@ -0,0 +1,147 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package linelength
+
+import (
+	"fmt"
+
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// Messages emitted by the checks.
+const (
+	CodeMessage    = "Wrap line at column %d (currently %d)."
+	HeredocMessage = "Wrap heredoc at column %d (currently %d)."
+	StringMessage  = "Wrap string at column %d (currently %d)."
+)
+
+// lineLengthConfig represents the configuration for the LineLengthRule.
+type lineLengthConfig struct {
+	// Allow lines holding a whitespace-free literal, e.g. an ARN or a hash,
+	// that crosses the limit to bust it.
+	AllowLongLiteral bool `hclext:"allow_long_literal,optional" hcl:"allow_long_literal,optional"`
+	// Allow lines with a URL to bust the limit.
+	AllowURL bool `hclext:"allow_url,optional" hcl:"allow_url,optional"`
+	// Maximum column of code lines. 0 turns the check off.
+	Code    int   `hclext:"code,optional" hcl:"code,optional"`
+	Enabled *bool `hclext:"enabled,optional" hcl:"enabled,optional"`
+	// Maximum column of heredoc body lines. 0 turns the check off.
+	Heredoc int    `hclext:"heredoc,optional" hcl:"heredoc,optional"`
+	Level   string `hclext:"level,optional" hcl:"level,optional"`
+	// Maximum column of lines holding a quoted string. 0 turns the check off.
+	String int `hclext:"string,optional" hcl:"string,optional"`
+	// Number of columns between tab stops.
+	TabWidth int `hclext:"tab_width,optional" hcl:"tab_width,optional"`
+}
+
+// Validate checks the values of the config.
+func (c *lineLengthConfig) Validate() error {
+	for _, limit := range []struct {
+		name  string
+		value int
+	}{{"code", c.Code}, {"heredoc", c.Heredoc}, {"string", c.String}} {
+		if limit.value < 0 {
+			return fmt.Errorf("%q must be at least 0, not %d", limit.name, limit.value)
+		}
+	}
+	if c.TabWidth < 1 {
+		return fmt.Errorf("\"tab_width\" must be at least 1, not %d", c.TabWidth)
+	}
+	return nil
+}
+
+// defaultLineLengthConfig is the default configuration for the
+// LineLengthRule.
+var defaultLineLengthConfig = lineLengthConfig{
+	AllowLongLiteral: true,
+	AllowURL:         true,
+	Code:             120,
+	Enabled:          rulehelper.BoolPtr(true),
+	Heredoc:          160,
+	Level:            "warning",
+	String:           120,
+	TabWidth:         rulehelper.DefaultTabWidth,
+}
+
+// Rule checks the width of every line of code, string and heredoc. Comment
+// lines are left to eos_comments.
+type Rule struct {
+	tflint.DefaultRule
+	Config lineLengthConfig
+	// RuleName is the rule block name to load from the config file. If empty,
+	// defaults to "eos_line_length".
+	RuleName string
+	// overrides are the override blocks of the rule block, applied by ForFile.
+	overrides rulehelper.Overrides[lineLengthConfig]
+}
+
+// Check checks whether the rule conditions are met.
+func (r *Rule) Check(runner tflint.Runner) error {
+	ignores, overrides, err := rulehelper.BeginCheck(runner, r, &r.Config)
+	if err != nil || ignores == nil {
+		return err
+	}
+	r.overrides = overrides
+
+	if err := rulehelper.WalkFileTokens(ignores, r, checkLines); err != nil {
+		return err
+	}
+	return ignores.ReportIgnores()
+}
+
+// limitOf returns the limit, message and sub-rule name of a kind of line.
+func (r *Rule) limitOf(kind lineKind) (int, string, string) {
+	switch kind {
+	case kindHeredoc:
+		return r.Config.Heredoc, HeredocMessage, "heredoc"
+	case kindString:
+		return r.Config.String, StringMessage, "string"
+	default:
+		return r.Config.Code, CodeMessage, "code"
+	}
+}
+
+// NewLineLengthRule returns a new rule.
+func NewLineLengthRule() *Rule {
+	rule := &Rule{}
+	rule.Config = defaultLineLengthConfig
+	return rule
+}
+
+// ForFile returns the rule with the config that applies to filename.
+func (r *Rule) ForFile(filename string) *Rule {
+	config, ok := r.overrides.Match(filename)
+	if !ok {
+		return r
+	}
+	fileRule := *r
+	fileRule.Config = config
+	return &fileRule
+}
+
+// Enabled returns whether the rule is enabled by default.
+func (r *Rule) Enabled() bool {
+	return r.Config.Enabled == nil || *r.Config.Enabled
+}
+
+// Link returns the rule reference link.
+func (r *Rule) Link() string {
+	return "https://github.com/tfctl/tflint-ruleset-elements-of-style/blob/main/docs/rules/eos_line_length.md"
+}
+
+// Name returns the rule name.
+func (r *Rule) Name() string {
+	if r.RuleName != "" {
+		return r.RuleName
+	}
+
+	return "eos_line_length"
+}
+
+// Severity returns the rule severity.
+func (r *Rule) Severity() tflint.Severity {
+	return rulehelper.ToSeverity(r.Config.Level)
+}
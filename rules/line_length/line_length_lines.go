@@ -0,0 +1,144 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package linelength
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// lineKind is what a line holds, and so which limit applies to it. A line
+// holding more than one kind takes the greatest.
+type lineKind int
+
+const (
+	// kindNone is a blank or comment-only line, which isn't checked.
+	kindNone lineKind = iota
+	kindCode
+	kindString
+	kindHeredoc
+)
+
+// checkLines checks the width of each line of a file against the limit of its
+// kind.
+func checkLines(runner tflint.Runner, r *Rule, source *rulehelper.SourceFile, tokens hclsyntax.Tokens) {
+	kinds, literals := classifyLines(tokens)
+	// The lexer always ends with an EOF token, so there is a first token.
+	filename := tokens[0].Range.Filename
+
+	offset := 0
+	for i, line := range bytes.Split(source.File().Bytes, []byte("\n")) {
+		start := offset
+		offset += len(line) + 1
+
+		number := i + 1
+		if kinds[number] == kindNone {
+			continue
+		}
+		limit, format, subRule := r.limitOf(kinds[number])
+		if limit <= 0 {
+			continue
+		}
+
+		text := strings.TrimRight(string(line), "\r")
+		width := rulehelper.DisplayWidth(text, r.Config.TabWidth)
+		if width <= limit {
+			continue
+		}
+		if r.Config.AllowURL && (strings.Contains(text, "http://") || strings.Contains(text, "https://")) {
+			continue
+		}
+		if r.Config.AllowLongLiteral && r.hasLongLiteral(text, start, literals[number], limit) {
+			continue
+		}
+
+		rng := hcl.Range{
+			Filename: filename,
+			Start:    hcl.Pos{Line: number, Column: 1, Byte: start},
+			End:      hcl.Pos{Line: number, Column: utf8.RuneCountInString(text) + 1, Byte: start + len(text)},
+		}
+		rulehelper.EmitIssue(runner, r, subRule, "", fmt.Sprintf(format, limit, width), rng)
+	}
+}
+
+// classifyLines returns the kind of each line holding a token, and the string
+// literals starting on each line. Heredoc bodies and quoted strings are told
+// apart from code by the open quotes and heredocs around a token.
+func classifyLines(tokens hclsyntax.Tokens) (map[int]lineKind, map[int][]hclsyntax.Token) {
+	kinds := map[int]lineKind{}
+	literals := map[int][]hclsyntax.Token{}
+
+	// open holds the quotes and heredocs the current token is inside, innermost
+	// last. Interpolations inside them count as part of the string.
+	var open []lineKind
+	mark := func(token hclsyntax.Token, kind lineKind) {
+		if len(open) > 0 && open[len(open)-1] > kind {
+			kind = open[len(open)-1]
+		}
+		if line := token.Range.Start.Line; kind > kinds[line] {
+			kinds[line] = kind
+		}
+	}
+
+	for _, token := range tokens {
+		switch token.Type {
+		case hclsyntax.TokenComment, hclsyntax.TokenNewline, hclsyntax.TokenEOF:
+		case hclsyntax.TokenOQuote:
+			open = append(open, kindString)
+			mark(token, kindString)
+		case hclsyntax.TokenCQuote:
+			mark(token, kindString)
+			open = open[:max(len(open)-1, 0)]
+		case hclsyntax.TokenOHeredoc:
+			mark(token, kindCode)
+			open = append(open, kindHeredoc)
+		case hclsyntax.TokenCHeredoc:
+			// The closing marker is on a line of its own, after the body.
+			open = open[:max(len(open)-1, 0)]
+			mark(token, kindCode)
+		case hclsyntax.TokenQuotedLit, hclsyntax.TokenStringLit:
+			literals[token.Range.Start.Line] = append(literals[token.Range.Start.Line], token)
+			mark(token, kindCode)
+		default:
+			mark(token, kindCode)
+		}
+	}
+
+	return kinds, literals
+}
+
+// hasLongLiteral reports whether one of the literals on a line is free of
+// whitespace, at least half the limit wide and crosses the limit, e.g. an ARN
+// or a hash. Such a literal can't be wrapped, and moving it to a line of its
+// own rarely helps. Short literals, e.g. the items of a list, can be moved, so
+// they don't count. start is the offset of the line in the file.
+func (r *Rule) hasLongLiteral(text string, start int, literals []hclsyntax.Token, limit int) bool {
+	for _, literal := range literals {
+		from := literal.Range.Start.Byte - start
+		value := strings.TrimRight(string(literal.Bytes), "\r\n")
+		// A heredoc line is a literal of its own, indentation and all.
+		trimmed := strings.TrimLeft(value, " \t")
+		from += len(value) - len(trimmed)
+		to := from + len(trimmed)
+		if trimmed == "" || strings.IndexFunc(trimmed, unicode.IsSpace) >= 0 || to > len(text) {
+			continue
+		}
+
+		before := rulehelper.DisplayWidth(text[:from], r.Config.TabWidth)
+		after := rulehelper.DisplayWidth(text[:to], r.Config.TabWidth)
+		if before < limit && after > limit && 2*(after-before) >= limit {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package linelength
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/testhelper"
+)
+
+func TestLineLength(t *testing.T) {
+	if !flag.Parsed() {
+		flag.Parse()
+	}
+
+	t.Run("Config", testLineLengthConfig)
+	t.Run("ConfigErrors", testLineLengthConfigErrors)
+
+	t.Run("Levels", testLineLengthLevels)
+	t.Run("Rule", testLineLengthRule)
+}
+
+func testLineLengthConfig(t *testing.T) {
+	cases := []testhelper.ConfigTestCase{
+		{
+			Name: "eos_line_length",
+			Want: defaultLineLengthConfig,
+		},
+		{
+			Name: "eos_line_length_narrow",
+			Want: func() lineLengthConfig {
+				cfg := defaultLineLengthConfig
+				cfg.Code = 40
+				cfg.Heredoc = 60
+				cfg.String = 50
+				cfg.TabWidth = 8
+				return cfg
+			}(),
+		},
+	}
+
+	testhelper.ConfigTestRunner(t, defaultLineLengthConfig, cases)
+}
+
+func testLineLengthConfigErrors(t *testing.T) {
+	cases := []testhelper.ConfigErrorTestCase{
+		{
+			Name: "eos_line_length_bad_limit",
+			Want: `rule "eos_line_length_bad_limit": "code" must be at least 0, not -1`,
+		},
+		{
+			Name: "eos_line_length_typo",
+			Want: `rule "eos_line_length_typo": unknown option "column" at testdata/.tflint_test.hcl:33,3-9. Valid options: allow_long_literal, allow_url, code, enabled, heredoc, level, string, tab_width, override { files, ... }.`,
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewLineLengthRule() }
+	testhelper.ConfigErrorTestRunner(t, ruleFactory, cases)
+}
+
+func testLineLengthRule(t *testing.T) {
+	content, _ := os.ReadFile("./testdata/line_length_test.tf")
+
+	cases := []testhelper.RuleTestCase{
+		{
+			Name:    "eos_line_length",
+			Content: string(content),
+			Want: []string{
+				fmt.Sprintf(StringMessage, 120, 130),
+			},
+		},
+		{
+			Name:    "eos_line_length_narrow",
+			Content: string(content),
+			Want: []string{
+				fmt.Sprintf(StringMessage, 50, 130),
+				fmt.Sprintf(CodeMessage, 40, 50),
+				fmt.Sprintf(StringMessage, 50, 57),
+				// The CJK names are two columns wide each.
+				fmt.Sprintf(StringMessage, 50, 62),
+				// The annotation names line_length.code, not this rule.
+				fmt.Sprintf(CodeMessage, 40, 54),
+				fmt.Sprintf(HeredocMessage, 60, 66),
+				fmt.Sprintf(CodeMessage, 40, 41),
+			},
+		},
+		{
+			Name:    "eos_line_length_strict",
+			Content: string(content),
+			Want: []string{
+				fmt.Sprintf(StringMessage, 50, 130),
+				fmt.Sprintf(CodeMessage, 40, 50),
+				fmt.Sprintf(StringMessage, 50, 57),
+				fmt.Sprintf(StringMessage, 50, 62),
+				// The annotation names line_length.code, not this rule.
+				fmt.Sprintf(CodeMessage, 40, 54),
+				fmt.Sprintf(StringMessage, 50, 58),
+				fmt.Sprintf(StringMessage, 50, 55),
+			},
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewLineLengthRule() }
+	testhelper.RuleTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "line_length_test.tf")
+}
+
+func testLineLengthLevels(t *testing.T) {
+	cases := []testhelper.SeverityTestCase{
+		{
+			Name: "eos_line_length_strict",
+			Content: `locals {
+  enabled_regions = var.enabled ? var.regions : []
+}
+`,
+			Want: map[string]tflint.Severity{
+				fmt.Sprintf(CodeMessage, 40, 50): tflint.NOTICE,
+			},
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewLineLengthRule() }
+	testhelper.SeverityTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "line_length_levels.tf")
+}
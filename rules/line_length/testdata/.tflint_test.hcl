@@ -0,0 +1,34 @@
+# Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+# SPDX-License-Identifier: Apache-2.0
+
+rule "eos_line_length" {
+  enabled = true
+}
+
+rule "eos_line_length_narrow" {
+  enabled   = true
+  code      = 40
+  heredoc   = 60
+  string    = 50
+  tab_width = 8
+}
+
+rule "eos_line_length_strict" {
+  enabled            = true
+  level              = "notice"
+  allow_long_literal = false
+  allow_url          = false
+  code               = 40
+  heredoc            = 0
+  string             = 50
+}
+
+rule "eos_line_length_bad_limit" {
+  enabled = true
+  code    = -1
+}
+
+rule "eos_line_length_typo" {
+  enabled = true
+  column  = 80
+}
@@ -0,0 +1,44 @@
+# Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+# SPDX-License-Identifier: Apache-2.0
+
+# #########
+# Tests that will emit issues.
+
+variable "retention" {
+  description = "The number of days to keep the access logs for, after which they are moved to the archive tier and then deleted."
+  type        = number
+}
+
+# #########
+# Tests that will emit issues under the narrow limits, but not the defaults.
+
+locals {
+  enabled_regions = var.enabled ? var.regions : []
+  description     = "The bucket holding the access logs."
+  region_names    = ["東京", "大阪", "ソウル", "シンガポール"]
+
+  # eos-ignore: line_length.code -- kept on one line
+  region_count = var.enabled ? length(var.regions) : 0
+}
+
+resource "aws_iam_policy" "logs" {
+  policy = <<-EOT
+    {"Statement": [{"Effect": "Allow", "Action": "s3:PutObject"}]}
+  EOT
+}
+
+# A tab is eight columns wide under the narrow limits.
+resource "aws_s3_bucket" "tabbed" {
+	bucket_prefix = var.bucket_prefix
+}
+
+# #########
+# Tests that will not emit issues under the narrow limits.
+
+# A comment line is left to eos_comments, however long it runs past the limit.
+
+locals {
+  docs_url   = "https://example.com/docs/bucket-logs.html"
+  policy_arn = "arn:aws:iam::aws:policy/ReadOnlyAccess"
+  café       = "crème brûlée"
+}
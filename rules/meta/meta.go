@@ -16,14 +16,26 @@ import (
 type OrderConfig struct {
 	First []string `hclext:"first,optional" hcl:"first,optional"`
 	Last  []string `hclext:"last,optional" hcl:"last,optional"`
+	// Issue level for misordered arguments. Defaults to the rule level.
+	Level string `hclext:"level,optional" hcl:"level,optional"`
 }
 
 // metaConfig represents the configuration for the MetaRule.
 type metaConfig struct {
-	Enabled       *bool         `hclext:"enabled,optional" hcl:"enabled,optional"`
-	Level         string        `hclext:"level,optional" hcl:"level,optional"`
-	Order         []OrderConfig `hclext:"order,block" hcl:"order,block"`
-	SourceVersion *bool         `hcl:"source_version,optional"`
+	CountGuard    rulehelper.SubCheck `hclext:"count_guard,optional" hcl:"count_guard,optional"`
+	Enabled       *bool               `hclext:"enabled,optional" hcl:"enabled,optional"`
+	Level         string              `hclext:"level,optional" hcl:"level,optional"`
+	Order         []OrderConfig       `hclext:"order,block" hcl:"order,block"`
+	SourceVersion rulehelper.SubCheck `hclext:"source_version,optional" hcl:"source_version,optional"`
+}
+
+// metaArgumentSchema holds the arguments of a block the checks look at.
+var metaArgumentSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{
+		{Name: "count"},
+		{Name: "source"},
+		{Name: "version"},
+	},
 }
 
 // defaultMetaConfig is the default configuration for the MetaRule.
@@ -31,10 +43,10 @@ var defaultMetaConfig = metaConfig{
 	Enabled: rulehelper.BoolPtr(true),
 	Level:   "warning",
 	Order: []OrderConfig{{
-		First: []string{"for_each", "count"},
-		Last:  []string{"depends_on", "provider", "lifecycle"},
+		First: rulehelper.FirstMetaArguments,
+		Last:  rulehelper.LastMetaArguments,
 	}},
-	SourceVersion: rulehelper.BoolPtr(true),
+	SourceVersion: "true",
 }
 
 // Rule checks for meta-argument style violations.
@@ -44,48 +56,61 @@ type Rule struct {
 	// RuleName is the rule block name to load from the config file. If empty,
 	// defaults to "eos_meta".
 	RuleName string
-	// ConfigFile is the path to the config file. If empty, LoadRuleConfig will
-	// search CWD then $HOME for .tflint.hcl.
-	ConfigFile string
+	// overrides are the override blocks of the rule block, applied by ForFile.
+	overrides rulehelper.Overrides[metaConfig]
 }
 
 // Check checks whether the rule conditions are met.
 func (r *Rule) Check(runner tflint.Runner) error {
-	// Load config using the rule name and optional config file path.
-	if err := rulehelper.LoadRuleConfig(r.Name(), &r.Config, r.ConfigFile); err != nil {
+	ignores, overrides, err := rulehelper.BeginCheck(runner, r, &r.Config)
+	if err != nil || ignores == nil {
 		return err
 	}
+	r.overrides = overrides
 
-	// Bail out early if the rule is not enabled. This will occur if the EOS
-	// plugin is enabled, but this specific rule is not.
-	if !r.Enabled() {
-		return nil
-	}
-
-	files, err := runner.GetFiles()
+	files, err := ignores.GetFiles()
 	if err != nil {
 		return err
 	}
 
-	for _, file := range files {
+	for filename, file := range files {
+		fileRule, enabled := rulehelper.ForFile(r, filename)
+		if !enabled {
+			continue
+		}
+
+		// Argument order is lost when JSON is decoded, so it is only checked
+		// in native files.
 		if body, ok := file.Body.(*hclsyntax.Body); ok {
 			for _, block := range body.Blocks {
-				checkOrder(runner, r, block)
-				if attr, exists := block.Body.Attributes["count"]; exists {
-					checkCountGuard(runner, r, attr)
-				}
-				if block.Type == "module" && (r.Config.SourceVersion == nil || *r.Config.SourceVersion) {
-					checkModuleSourceVersion(runner, r, block)
-				}
+				checkOrder(rulehelper.SubRule(ignores, "order"), fileRule, block)
+			}
+		}
+
+		// The remaining checks go through the generic hcl.Body API, so they
+		// cover JSON files too.
+		for _, block := range rulehelper.Source(filename, file).Blocks() {
+			args, _, _ := block.Body.PartialContent(metaArgumentSchema)
+			if attr, exists := args.Attributes["count"]; exists && fileRule.Config.CountGuard.Enabled() {
+				checkCountGuard(rulehelper.SubRule(ignores, "count_guard"), fileRule, attr)
+			}
+			if block.Type == "module" && fileRule.Config.SourceVersion.Enabled() {
+				checkModuleSourceVersion(rulehelper.SubRule(ignores, "source_version"), fileRule, block, args.Attributes)
 			}
 		}
 	}
 
-	return nil
+	return ignores.ReportIgnores()
+}
+
+func (r *Rule) emitIssue(runner tflint.Runner, level string, message string, rng hcl.Range) {
+	if err := runner.EmitIssue(rulehelper.WithLevel(r, level), message, rng); err != nil {
+		logger.Error(err.Error())
+	}
 }
 
-func (r *Rule) emitIssue(runner tflint.Runner, message string, rng hcl.Range) {
-	if err := runner.EmitIssue(r, message, rng); err != nil {
+func (r *Rule) emitIssueWithFix(runner tflint.Runner, level string, message string, rng hcl.Range, fixFunc func(tflint.Fixer) error) {
+	if err := runner.EmitIssueWithFix(rulehelper.WithLevel(r, level), message, rng, fixFunc); err != nil {
 		logger.Error(err.Error())
 	}
 }
@@ -94,9 +119,23 @@ func (r *Rule) emitIssue(runner tflint.Runner, message string, rng hcl.Range) {
 func NewMetaRule() *Rule {
 	rule := &Rule{}
 	rule.Config = defaultMetaConfig
+	// Decoding an order block writes into the slice, so the default must not
+	// be shared.
+	rule.Config.Order = append([]OrderConfig(nil), defaultMetaConfig.Order...)
 	return rule
 }
 
+// ForFile returns the rule with the config that applies to filename.
+func (r *Rule) ForFile(filename string) *Rule {
+	config, ok := r.overrides.Match(filename)
+	if !ok {
+		return r
+	}
+	fileRule := *r
+	fileRule.Config = config
+	return &fileRule
+}
+
 // Enabled returns whether the rule is enabled by default.
 func (r *Rule) Enabled() bool {
 	return r.Config.Enabled == nil || *r.Config.Enabled
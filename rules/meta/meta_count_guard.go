@@ -4,6 +4,9 @@
 package meta
 
 import (
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
+	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 	"github.com/zclconf/go-cty/cty"
@@ -12,9 +15,14 @@ import (
 const OnlyDynamicGuardMessage = "Avoid using count for anything other than dynamic guarding (condition ? 1 : 0)."
 const GuardMustReturn10Message = "Count guard must return 1 or 0."
 
-// checkCountGuard checks for proper count guard usage.
-func checkCountGuard(runner tflint.Runner, r *Rule, attr *hclsyntax.Attribute) {
-	expr := attr.Expr
+// checkCountGuard checks for proper count guard usage. A JSON count is checked
+// as the native expression it holds.
+func checkCountGuard(runner tflint.Runner, r *Rule, attr *hcl.Attribute) {
+	expr, ok := rulehelper.NativeExpression(attr.Expr)
+	if !ok {
+		r.emitIssue(runner, r.Config.CountGuard.Level(), OnlyDynamicGuardMessage, attr.Range)
+		return
+	}
 	condExpr, ok := expr.(*hclsyntax.ConditionalExpr)
 
 	// We want to check if it is a conditional expression:
@@ -28,13 +36,13 @@ func checkCountGuard(runner tflint.Runner, r *Rule, attr *hclsyntax.Attribute) {
 			}
 		}
 
-		r.emitIssue(runner, OnlyDynamicGuardMessage, attr.Range())
+		r.emitIssue(runner, r.Config.CountGuard.Level(), OnlyDynamicGuardMessage, attr.Range)
 		return
 	}
 
 	// Check true/false results.
 	if !isValidGuardResult(condExpr.TrueResult) || !isValidGuardResult(condExpr.FalseResult) {
-		r.emitIssue(runner, GuardMustReturn10Message, attr.Range())
+		r.emitIssue(runner, r.Config.CountGuard.Level(), GuardMustReturn10Message, attr.Range)
 	}
 }
 
@@ -4,6 +4,13 @@
 package meta
 
 import (
+	"bytes"
+	"sort"
+	"strings"
+
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
+	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 )
@@ -12,10 +19,21 @@ import (
 // configured order.
 const MisOrderedMessage = "Meta arguments should be ordered consistently"
 
+// bodyItem is an attribute or nested block in a block body, classified against
+// the Order config.
+type bodyItem struct {
+	node    hclsyntax.Node
+	name    string
+	rng     hcl.Range
+	isFirst bool
+	isLast  bool
+}
+
 // checkOrder verifies that arguments in a block respect the Order config.
 // Arguments in Order.First must appear before all other arguments. Arguments
 // in Order.Last must appear after all other arguments. Arguments not in either
-// list can appear anywhere in between.
+// list can appear anywhere in between. Nested blocks, such as lifecycle, are
+// ordered the same way as attributes.
 func checkOrder(runner tflint.Runner, r *Rule, block *hclsyntax.Block) {
 	if len(r.Config.Order) == 0 {
 		return
@@ -35,48 +53,49 @@ func checkOrder(runner tflint.Runner, r *Rule, block *hclsyntax.Block) {
 		lastSet[name] = true
 	}
 
-	// Collect all attributes with their line numbers and classification.
-	type attrInfo struct {
-		name       string
-		lineNumber int
-		isFirst    bool
-		isLast     bool
-	}
-
-	var attrs []attrInfo
+	// Collect all attributes and nested blocks with their classification.
+	var items []bodyItem
 	for name, attr := range block.Body.Attributes {
-		info := attrInfo{
-			name:       name,
-			lineNumber: attr.SrcRange.Start.Line,
-			isFirst:    firstSet[name],
-			isLast:     lastSet[name],
-		}
-		attrs = append(attrs, info)
+		items = append(items, bodyItem{
+			node:    attr,
+			name:    name,
+			rng:     attr.SrcRange,
+			isFirst: firstSet[name],
+			isLast:  lastSet[name],
+		})
+	}
+	for _, nested := range block.Body.Blocks {
+		items = append(items, bodyItem{
+			node:    nested,
+			name:    nested.Type,
+			rng:     nested.Range(),
+			isFirst: firstSet[nested.Type],
+			isLast:  lastSet[nested.Type],
+		})
 	}
 
-	if len(attrs) == 0 {
+	if len(items) == 0 {
 		return
 	}
 
-	// Sort by line number to get the actual order in the source.
-	for i := 0; i < len(attrs)-1; i++ {
-		for j := i + 1; j < len(attrs); j++ {
-			if attrs[i].lineNumber > attrs[j].lineNumber {
-				attrs[i], attrs[j] = attrs[j], attrs[i]
-			}
-		}
+	// Sort by position to get the actual order in the source.
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].rng.Start.Byte < items[j].rng.Start.Byte
+	})
+
+	fix := func(f tflint.Fixer) error {
+		return fixOrder(f, runner, block, items)
 	}
 
 	// Check that First arguments appear before all non-First arguments. Once
 	// we see a non-First argument, any subsequent First argument is out of
 	// order.
 	seenNonFirst := false
-	for _, attr := range attrs {
-		if !attr.isFirst {
+	for _, item := range items {
+		if !item.isFirst {
 			seenNonFirst = true
 		} else if seenNonFirst {
-			a := block.Body.Attributes[attr.name]
-			r.emitIssue(runner, MisOrderedMessage, a.SrcRange)
+			r.emitIssueWithFix(runner, order.Level, MisOrderedMessage, item.rng, fix)
 			return
 		}
 	}
@@ -84,14 +103,114 @@ func checkOrder(runner tflint.Runner, r *Rule, block *hclsyntax.Block) {
 	// Check that Last arguments appear after all non-Last arguments. Once we
 	// see a Last argument, any subsequent non-Last argument is out of order.
 	seenLast := false
-	for _, attr := range attrs {
-		if attr.isLast {
+	for _, item := range items {
+		if item.isLast {
 			seenLast = true
 		} else if seenLast {
 			// A non-Last argument appears after a Last argument.
-			a := block.Body.Attributes[attr.name]
-			r.emitIssue(runner, MisOrderedMessage, a.SrcRange)
+			r.emitIssueWithFix(runner, order.Level, MisOrderedMessage, item.rng, fix)
 			return
 		}
 	}
 }
+
+// orderChunk is the source of a body item together with the comments attached
+// to it.
+type orderChunk struct {
+	item        bodyItem
+	text        string
+	blankBefore bool
+}
+
+// fixOrder rewrites the block body so that First items lead, Last items trail,
+// and everything else keeps its relative order in between. Each item moves with
+// the comments attached to it, i.e. those above it and its EOL comment. The
+// three groups are separated by a single blank line, and blank lines within a
+// group are kept.
+func fixOrder(f tflint.Fixer, runner tflint.Runner, block *hclsyntax.Block, items []bodyItem) error {
+	filename := block.Range().Filename
+	file, err := runner.GetFile(filename)
+	if err != nil || file == nil {
+		return tflint.ErrFixNotSupported
+	}
+	src := file.Bytes
+	comments := rulehelper.Source(filename, file).CommentMap()
+	lines := bytes.SplitAfter(src, []byte("\n"))
+
+	// lineOffset returns the byte offset of the start of a 1-based line.
+	lineOffset := func(line int) int {
+		offset := 0
+		for _, l := range lines[:line-1] {
+			offset += len(l)
+		}
+		return offset
+	}
+
+	// Items must sit on their own lines between the braces, otherwise moving
+	// whole lines would take the braces or a neighbour with them.
+	openLine := block.OpenBraceRange.Start.Line
+	closeLine := block.CloseBraceRange.Start.Line
+	prevEnd := openLine
+	for _, item := range items {
+		if item.rng.Start.Line <= prevEnd || item.rng.End.Line >= closeLine {
+			return tflint.ErrFixNotSupported
+		}
+		prevEnd = item.rng.End.Line
+	}
+
+	var chunks []orderChunk
+	prevEnd = openLine
+	for _, item := range items {
+		// Blank lines before the first comment leading the item are only a
+		// separator.
+		start := comments.Extent(item.node).Start.Line
+
+		var text strings.Builder
+		for _, l := range lines[start-1 : item.rng.End.Line] {
+			text.Write(l)
+		}
+		chunk := orderChunk{
+			item:        item,
+			text:        text.String(),
+			blankBefore: start > prevEnd+1,
+		}
+		if !strings.HasSuffix(chunk.text, "\n") {
+			chunk.text += "\n"
+		}
+		chunks = append(chunks, chunk)
+		prevEnd = item.rng.End.Line
+	}
+
+	var first, middle, last []orderChunk
+	for _, chunk := range chunks {
+		switch {
+		case chunk.item.isFirst:
+			first = append(first, chunk)
+		case chunk.item.isLast:
+			last = append(last, chunk)
+		default:
+			middle = append(middle, chunk)
+		}
+	}
+
+	var body strings.Builder
+	for _, group := range [][]orderChunk{first, middle, last} {
+		for i, chunk := range group {
+			if body.Len() > 0 && (i == 0 || chunk.blankBefore) {
+				body.WriteString("\n")
+			}
+			body.WriteString(chunk.text)
+		}
+	}
+
+	// Replace from the first line of the first chunk through the last line of
+	// the last item so that the separator before the first item, and anything
+	// dangling before the closing brace, are left untouched.
+	firstStart := comments.Extent(items[0].node).Start.Line
+	rng := hcl.Range{
+		Filename: filename,
+		Start:    hcl.Pos{Line: firstStart, Column: 1, Byte: lineOffset(firstStart)},
+		End:      hcl.Pos{Line: prevEnd + 1, Column: 1, Byte: lineOffset(prevEnd + 1)},
+	}
+	return f.ReplaceText(rng, body.String())
+}
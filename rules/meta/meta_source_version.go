@@ -7,8 +7,9 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
 	"github.com/hashicorp/hcl/v2"
-	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 	"github.com/zclconf/go-cty/cty"
 )
@@ -21,9 +22,10 @@ var validHTTPSExtensions = []string{
 	".xz", ".tar.xz", ".txz",
 }
 
-// checkModuleSourceVersion checks for proper module source versioning.
-func checkModuleSourceVersion(runner tflint.Runner, r *Rule, block *hclsyntax.Block) {
-	sourceAttr, exists := block.Body.Attributes["source"]
+// checkModuleSourceVersion checks for proper module source versioning. attrs
+// are the meta-arguments decoded from the module block.
+func checkModuleSourceVersion(runner tflint.Runner, r *Rule, block *hcl.Block, attrs hcl.Attributes) {
+	sourceAttr, exists := attrs["source"]
 	if !exists {
 		return
 	}
@@ -46,7 +48,7 @@ func checkModuleSourceVersion(runner tflint.Runner, r *Rule, block *hclsyntax.Bl
 
 	if isGitSource(source) {
 		if !strings.Contains(source, "ref=") {
-			r.emitIssue(runner, "Git module source should specify ref parameter.", block.Range())
+			r.emitIssue(runner, r.Config.SourceVersion.Level(), "Git module source should specify ref parameter.", rulehelper.BlockRange(block))
 		}
 		return
 	}
@@ -68,22 +70,22 @@ func checkModuleSourceVersion(runner tflint.Runner, r *Rule, block *hclsyntax.Bl
 		}
 
 		if !found {
-			r.emitIssue(runner, "https module source should specify a valid archive extension.", block.Range())
+			r.emitIssue(runner, r.Config.SourceVersion.Level(), "https module source should specify a valid archive extension.", rulehelper.BlockRange(block))
 		}
 		return
 	}
 
 	if isMercurialSource(source) {
 		if !strings.Contains(source, "#") {
-			r.emitIssue(runner, "Mercurial module source should specify #revision.", block.Range())
+			r.emitIssue(runner, r.Config.SourceVersion.Level(), "Mercurial module source should specify #revision.", rulehelper.BlockRange(block))
 		}
 		return
 	}
 
 	if isRegistrySource(source) {
-		versionAttr, exists := block.Body.Attributes["version"]
+		versionAttr, exists := attrs["version"]
 		if !exists {
-			r.emitIssue(runner, "Module from registry should specify version.", block.Range())
+			r.emitIssue(runner, r.Config.SourceVersion.Level(), "Module from registry should specify version.", rulehelper.BlockRange(block))
 			return
 		}
 
@@ -104,14 +106,14 @@ func checkModuleSourceVersion(runner tflint.Runner, r *Rule, block *hclsyntax.Bl
 			if strings.HasPrefix(c, "~>") {
 				ver := strings.TrimSpace(strings.TrimPrefix(c, "~>"))
 				if !strings.Contains(ver, ".") {
-					r.emitIssue(runner, "Pessimistic version constraint should specify at least major and minor version.", block.Range())
+					r.emitIssue(runner, r.Config.SourceVersion.Level(), "Pessimistic version constraint should specify at least major and minor version.", rulehelper.BlockRange(block))
 					return
 				}
 				continue
 			}
 
 			if strings.HasPrefix(c, ">") {
-				r.emitIssue(runner, "Version constraint > or >= should not be used. Use ~> or exact version.", block.Range())
+				r.emitIssue(runner, r.Config.SourceVersion.Level(), "Version constraint > or >= should not be used. Use ~> or exact version.", rulehelper.BlockRange(block))
 				return
 			}
 		}
@@ -8,9 +8,9 @@ import (
 	"os"
 	"testing"
 
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
 	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/testhelper"
-	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 )
 
 func TestMeta(t *testing.T) {
@@ -21,10 +21,93 @@ func TestMeta(t *testing.T) {
 	t.Run("Config", testMetaConfig)
 
 	t.Run("CountGuard", testMetaCountGuardRule)
+	t.Run("JSON", testMetaJSON)
+	t.Run("Levels", testMetaLevels)
 	t.Run("Order", testMetaOrderRule)
+	t.Run("OrderFix", testMetaOrderFix)
+	t.Run("Override", testMetaOverride)
 	t.Run("SourceVersion", testMetaSourceVersionRule)
 }
 
+func testMetaJSON(t *testing.T) {
+	cases := []testhelper.RuleTestCase{
+		{
+			Name: "eos_meta",
+			Content: `{
+  "resource": {
+    "aws_instance": {
+      "guarded": {
+        "count": "${var.enabled ? 1 : 0}",
+        "ami": "ami-123"
+      },
+      "literal": {
+        "count": 1,
+        "ami": "ami-123"
+      },
+      "fleet": {
+        "count": 3,
+        "ami": "ami-123"
+      },
+      "sized": {
+        "count": "${var.size}",
+        "ami": "ami-123"
+      },
+      "bad_guard": {
+        "count": "${var.enabled ? 2 : 0}",
+        "ami": "ami-123"
+      }
+    }
+  },
+  "module": {
+    "consul": {
+      "source": "hashicorp/consul/aws"
+    },
+    "pinned": {
+      "source": "hashicorp/consul/aws",
+      "version": "~> 0.1"
+    },
+    "local": {
+      "source": "./modules/local"
+    }
+  }
+}
+`,
+			Want: []string{
+				OnlyDynamicGuardMessage,
+				OnlyDynamicGuardMessage,
+				GuardMustReturn10Message,
+				"Module from registry should specify version.",
+			},
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewMetaRule() }
+	testhelper.RuleTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "meta_test.tf.json")
+}
+
+func testMetaOverride(t *testing.T) {
+	content := `module "consul" {
+  source = "hashicorp/consul/aws"
+}
+`
+
+	cases := []testhelper.FilesTestCase{
+		{
+			Name: "eos_meta_override",
+			Files: map[string]string{
+				"main.tf":            content,
+				"modules/vpc/vpc.tf": content,
+			},
+			Want: []string{
+				"Module from registry should specify version.",
+			},
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewMetaRule() }
+	testhelper.FilesTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases)
+}
+
 func testMetaConfig(t *testing.T) {
 	cases := []testhelper.ConfigTestCase{
 		{
@@ -33,11 +116,7 @@ func testMetaConfig(t *testing.T) {
 		},
 		{
 			Name: "eos_meta_disabled",
-			Want: func() metaConfig {
-				cfg := defaultMetaConfig
-				cfg.Enabled = rulehelper.BoolPtr(false)
-				return cfg
-			}(),
+			Want: defaultMetaConfig,
 		},
 		{
 			Name: "eos_meta_order",
@@ -55,7 +134,20 @@ func testMetaConfig(t *testing.T) {
 			Name: "eos_meta_source_version_disabled",
 			Want: func() metaConfig {
 				cfg := defaultMetaConfig
-				cfg.SourceVersion = rulehelper.BoolPtr(false)
+				cfg.SourceVersion = "false"
+				return cfg
+			}(),
+		},
+		{
+			Name: "eos_meta_levels",
+			Want: func() metaConfig {
+				cfg := defaultMetaConfig
+				cfg.CountGuard = "notice"
+				cfg.Order = []OrderConfig{{
+					First: []string{"count"},
+					Last:  []string{"depends_on"},
+					Level: "error",
+				}}
 				return cfg
 			}(),
 		},
@@ -86,6 +178,26 @@ func testMetaCountGuardRule(t *testing.T) {
 	testhelper.RuleTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "meta_count_guard_test.tf")
 }
 
+func testMetaLevels(t *testing.T) {
+	cases := []testhelper.SeverityTestCase{
+		{
+			Name: "eos_meta_levels",
+			Content: `resource "terraform_data" "example" {
+  input = "value"
+  count = 2
+}
+`,
+			Want: map[string]tflint.Severity{
+				OnlyDynamicGuardMessage: tflint.NOTICE,
+				MisOrderedMessage:       tflint.ERROR,
+			},
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewMetaRule() }
+	testhelper.SeverityTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "meta_levels.tf")
+}
+
 func testMetaOrderRule(t *testing.T) {
 	cases := []testhelper.RuleTestCase{
 		{
@@ -94,7 +206,7 @@ func testMetaOrderRule(t *testing.T) {
 				content, _ := os.ReadFile("./testdata/meta_order_test.tf")
 				return string(content)
 			}(),
-			Want: testhelper.MakeMessageList(MisOrderedMessage, 3),
+			Want: testhelper.MakeMessageList(MisOrderedMessage, 4),
 		},
 	}
 
@@ -102,6 +214,22 @@ func testMetaOrderRule(t *testing.T) {
 	testhelper.RuleTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "meta_order_test.tf")
 }
 
+func testMetaOrderFix(t *testing.T) {
+	content, _ := os.ReadFile("./testdata/meta_order_fix.tf")
+	fixed, _ := os.ReadFile("./testdata/meta_order_fix_fixed.tf")
+
+	cases := []testhelper.FixTestCase{
+		{
+			Name:    "eos_meta",
+			Content: string(content),
+			Want:    string(fixed),
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewMetaRule() }
+	testhelper.FixTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "meta_order_fix.tf")
+}
+
 func testMetaSourceVersionRule(t *testing.T) {
 	cases := []testhelper.RuleTestCase{
 		{
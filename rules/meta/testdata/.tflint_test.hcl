@@ -18,5 +18,24 @@ rule "eos_meta_order" {
 }
 
 rule "eos_meta_source_version_disabled" {
+  enabled = true
   source_version = false
 }
+
+rule "eos_meta_levels" {
+  enabled = true
+  count_guard = "notice"
+  order {
+    first = ["count"]
+    last  = ["depends_on"]
+    level = "error"
+  }
+}
+
+rule "eos_meta_override" {
+  enabled = true
+  override {
+    files          = ["modules/**"]
+    source_version = false
+  }
+}
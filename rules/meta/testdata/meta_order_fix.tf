@@ -0,0 +1,36 @@
+# Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+# SPDX-License-Identifier: Apache-2.0
+
+resource "terraform_data" "first_after_other" {
+  # The input describes each item.
+  input = each.value
+  # Iterate over the items.
+  for_each = { za = { l = 1 }, kp = { x = 2 } } # Two items.
+}
+
+resource "aws_instance" "last_before_other" {
+  ami = var.ami
+
+  lifecycle {
+    # Replace before destroying.
+    create_before_destroy = true
+  }
+
+  # Wait for the network.
+  depends_on = [aws_vpc.main]
+
+  instance_type = "t3.micro"
+
+  tags = {
+    Name = "example"
+  }
+  # Dangling comment stays put.
+}
+
+resource "terraform_data" "already_ordered" {
+  count = 1
+
+  input = "test"
+
+  depends_on = [terraform_data.first_after_other]
+}
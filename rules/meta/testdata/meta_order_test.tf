@@ -21,6 +21,14 @@ resource "terraform_data" "mixed_violation" {
   input      = each.value
 }
 
+# FAIL - Last block (lifecycle) appears before non-Last argument (input).
+resource "terraform_data" "lifecycle_before_other" {
+  lifecycle {
+    create_before_destroy = true
+  }
+  input = "test"
+}
+
 # PASS - No First or Last arguments.
 resource "terraform_data" "pass_no_meta" {
   input = "1"
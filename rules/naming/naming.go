@@ -6,7 +6,6 @@ package naming
 import (
 	"fmt"
 
-	"github.com/hashicorp/hcl/v2"
 	"github.com/terraform-linters/tflint-plugin-sdk/logger"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 
@@ -28,12 +27,12 @@ type typeEchoConfig struct {
 
 // namingRuleConfig represents the configuration for the NamingRule.
 type namingRuleConfig struct {
-	Enabled  *bool           `hclext:"enabled,optional" hcl:"enabled,optional"`
-	Level    string          `hclext:"level,optional" hcl:"level,optional"`
-	Length   *int            `hclext:"length,optional" hcl:"length,optional"`
-	Shout    *bool           `hclext:"shout,optional" hcl:"shout,optional"`
-	Snake    *bool           `hclext:"snake,optional" hcl:"snake,optional"`
-	TypeEcho *typeEchoConfig `hclext:"type_echo,optional" hcl:"type_echo,block"`
+	Enabled  *bool               `hclext:"enabled,optional" hcl:"enabled,optional"`
+	Level    string              `hclext:"level,optional" hcl:"level,optional"`
+	Length   *int                `hclext:"length,optional" hcl:"length,optional"`
+	Shout    rulehelper.SubCheck `hclext:"shout,optional" hcl:"shout,optional"`
+	Snake    rulehelper.SubCheck `hclext:"snake,optional" hcl:"snake,optional"`
+	TypeEcho *typeEchoConfig     `hclext:"type_echo,block" hcl:"type_echo,block"`
 }
 
 // defaultConfig is the default configuration for the NamingRule.
@@ -50,48 +49,30 @@ type Rule struct {
 	// RuleName is the rule block name to load from the config file. If empty,
 	// defaults to "eos_naming".
 	RuleName string
-	// ConfigFile is the path to the config file. If empty, LoadRuleConfig will
-	// search CWD then $HOME for .tflint.hcl.
-	ConfigFile string
+	// overrides are the override blocks of the rule block, applied by ForFile.
+	overrides rulehelper.Overrides[namingRuleConfig]
 }
 
 // Check checks whether the rule conditions are met.
 func (rule *Rule) Check(runner tflint.Runner) error {
-	// Load config using the rule name and optional config file path.
-	if err := rulehelper.LoadRuleConfig(rule.Name(), &rule.Config, rule.ConfigFile); err != nil {
+	ignores, overrides, err := rulehelper.BeginCheck(runner, rule, &rule.Config)
+	if err != nil || ignores == nil {
 		return err
 	}
+	rule.overrides = overrides
 	logger.Debug(fmt.Sprintf("rule.Config=%v", rule.Config))
 
-	// Bail out early if the rule is not enabled. This will occur if the EOS
-	// plugin is enabled, but this specific rule is not.
-	if !rule.Enabled() {
-		return nil
-	}
-
-	var checks []func(tflint.Runner, *Rule, hcl.Range, string, string, string)
-	length := defaultLimit
-	if rule.Config.Length != nil {
-		length = *rule.Config.Length
-	}
-	if length > 0 {
-		checks = append(checks, checkNameLength)
-	}
-
-	if rule.Config.Shout == nil || *rule.Config.Shout {
-		checks = append(checks, checkShout)
-	}
-
-	if rule.Config.Snake == nil || *rule.Config.Snake {
-		checks = append(checks, checkSnake)
-	}
-
-	te := rule.Config.TypeEcho
-	if te == nil || te.Enabled == nil || *te.Enabled {
-		checks = append(checks, checkTypeEcho)
+	// Each check decides for itself whether it is switched on, as overrides
+	// can switch it on or off for some files.
+	if err := rulehelper.WalkBlocks(ignores, rulehelper.AllLintableBlocks, rule,
+		checkNameLength,
+		checkShout,
+		checkSnake,
+		checkTypeEcho); err != nil {
+		return err
 	}
 
-	return rulehelper.WalkBlocks(runner, rulehelper.AllLintableBlocks, rule, checks...)
+	return ignores.ReportIgnores()
 }
 
 // NewNamingRule returns a new rule.
@@ -101,6 +82,17 @@ func NewNamingRule() *Rule {
 	return rule
 }
 
+// ForFile returns the rule with the config that applies to filename.
+func (rule *Rule) ForFile(filename string) *Rule {
+	config, ok := rule.overrides.Match(filename)
+	if !ok {
+		return rule
+	}
+	fileRule := *rule
+	fileRule.Config = config
+	return &fileRule
+}
+
 // Enabled returns whether the rule is enabled by default.
 func (rule *Rule) Enabled() bool {
 	return rule.Config.Enabled == nil || *rule.Config.Enabled
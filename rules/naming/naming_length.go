@@ -6,6 +6,8 @@ package naming
 import (
 	"fmt"
 
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
 	"github.com/hashicorp/hcl/v2"
 	"github.com/terraform-linters/tflint-plugin-sdk/logger"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
@@ -18,9 +20,9 @@ func checkNameLength(runner tflint.Runner, r *Rule, defRange hcl.Range, _ string
 		limit = *r.Config.Length
 	}
 
-	if len(name) > limit {
+	if limit > 0 && len(name) > limit {
 		message := fmt.Sprintf("Avoid names longer than %d ('%s' is %d).", limit, name, len(name))
-		if err := runner.EmitIssue(r, message, defRange); err != nil {
+		if err := rulehelper.SubRule(runner, "length").EmitIssue(r, message, defRange); err != nil {
 			logger.Error(err.Error())
 		}
 		logger.Debug(message)
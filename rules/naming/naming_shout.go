@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"unicode"
 
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
 	"github.com/hashicorp/hcl/v2"
 	"github.com/terraform-linters/tflint-plugin-sdk/logger"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
@@ -14,6 +16,10 @@ import (
 
 // checkShout checks if the name is all uppercase.
 func checkShout(runner tflint.Runner, r *Rule, defRange hcl.Range, _ string, name string, _ string) {
+	if !r.Config.Shout.Enabled() {
+		return
+	}
+
 	hasAlpha := false
 	allUpper := true
 
@@ -28,7 +34,7 @@ func checkShout(runner tflint.Runner, r *Rule, defRange hcl.Range, _ string, nam
 
 	if hasAlpha && allUpper {
 		message := fmt.Sprintf("Avoid SHOUTED names (%s)", name)
-		if err := runner.EmitIssue(r, message, defRange); err != nil {
+		if err := rulehelper.SubRule(runner, "shout").EmitIssue(rulehelper.WithLevel(r, r.Config.Shout.Level()), message, defRange); err != nil {
 			logger.Error(err.Error())
 		}
 		logger.Debug(message)
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"unicode"
 
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
 	"github.com/hashicorp/hcl/v2"
 	"github.com/terraform-linters/tflint-plugin-sdk/logger"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
@@ -14,6 +16,10 @@ import (
 
 // checkSnake checks if the name consists only of lowercase alphanumeric and underscores.
 func checkSnake(runner tflint.Runner, rule *Rule, defRange hcl.Range, _ string, name string, _ string) {
+	if !rule.Config.Snake.Enabled() {
+		return
+	}
+
 	valid := true
 	for _, ch := range name {
 		if !(unicode.IsLower(ch) || unicode.IsDigit(ch) || ch == '_') {
@@ -24,7 +30,7 @@ func checkSnake(runner tflint.Runner, rule *Rule, defRange hcl.Range, _ string,
 
 	if !valid {
 		message := fmt.Sprintf("Names should be snake_case (%s).", name)
-		if err := runner.EmitIssue(rule, message, defRange); err != nil {
+		if err := rulehelper.SubRule(runner, "snake").EmitIssue(rulehelper.WithLevel(rule, rule.Config.Snake.Level()), message, defRange); err != nil {
 			logger.Error(err.Error())
 		}
 		logger.Debug(message)
@@ -8,6 +8,7 @@ import (
 	"flag"
 	"testing"
 
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
 	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/testhelper"
 )
@@ -22,6 +23,86 @@ func TestNaming(t *testing.T) {
 	t.Run("Snake", testNamingSnakeRule)
 	t.Run("TypeEcho", testNamingTypeEchoRule)
 	t.Run("Config", testNamingConfig)
+	t.Run("Levels", testNamingLevels)
+	t.Run("Ignore", testNamingIgnore)
+	t.Run("JSON", testNamingJSON)
+	t.Run("Override", testNamingOverride)
+}
+
+func testNamingIgnore(t *testing.T) {
+	cases := []testhelper.RuleTestCase{
+		{
+			Name: "eos_naming",
+			Content: `# eos-ignore: naming.snake -- mirrors the upstream API
+variable "CamelCase" {}
+variable "kebab-case" {}
+`,
+			Want: []string{
+				"Names should be snake_case (kebab-case).",
+			},
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewNamingRule() }
+	testhelper.RuleTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "naming_ignore.tf")
+}
+
+func testNamingJSON(t *testing.T) {
+	cases := []testhelper.RuleTestCase{
+		{
+			Name: "eos_naming",
+			Content: `{
+  "variable": {
+    "CamelCase": {}
+  },
+  "locals": {
+    "kebab-case": "x"
+  },
+  "resource": {
+    "aws_instance": {
+      "web": {
+        "ami": "ami-123"
+      },
+      "ShoutyName": {
+        "ami": "ami-456"
+      }
+    }
+  }
+}
+`,
+			Want: []string{
+				"Names should be snake_case (CamelCase).",
+				"Names should be snake_case (kebab-case).",
+				"Names should be snake_case (ShoutyName).",
+			},
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewNamingRule() }
+	testhelper.RuleTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "naming_test.tf.json")
+}
+
+func testNamingOverride(t *testing.T) {
+	content := `variable "twenty_chars_long_name" {}
+variable "CamelName" {}
+`
+
+	cases := []testhelper.FilesTestCase{
+		{
+			Name: "eos_naming_override",
+			Files: map[string]string{
+				"main.tf":                content,
+				"modules/legacy/main.tf": content,
+			},
+			Want: []string{
+				"Avoid names longer than 16 ('twenty_chars_long_name' is 22).",
+				"Names should be snake_case (CamelName).",
+			},
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewNamingRule() }
+	testhelper.FilesTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases)
 }
 
 func testNamingConfig(t *testing.T) {
@@ -33,14 +114,12 @@ func testNamingConfig(t *testing.T) {
 		{
 			Name: "eos_naming_noshout",
 			Want: namingRuleConfig{
-				Shout: rulehelper.BoolPtr(false),
+				Shout: "false",
 			},
 		},
 		{
 			Name: "eos_naming_disabled",
-			Want: namingRuleConfig{
-				Enabled: rulehelper.BoolPtr(false),
-			},
+			Want: namingRuleConfig{},
 		},
 		{
 			Name: "eos_naming_negative_length",
@@ -81,3 +160,22 @@ func testNamingConfig(t *testing.T) {
 
 	testhelper.ConfigTestRunner(t, namingRuleConfig{}, cases)
 }
+
+func testNamingLevels(t *testing.T) {
+	cases := []testhelper.SeverityTestCase{
+		{
+			Name: "eos_naming_levels",
+			Content: `variable "SHOUT" {}
+variable "variable_echo" {}
+`,
+			Want: map[string]tflint.Severity{
+				"Avoid SHOUTED names (SHOUT)":                    tflint.NOTICE,
+				"Names should be snake_case (SHOUT).":            tflint.WARNING,
+				makeTypeEchoMessage("variable", "variable_echo"): tflint.ERROR,
+			},
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewNamingRule() }
+	testhelper.SeverityTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "naming_levels.tf")
+}
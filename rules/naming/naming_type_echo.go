@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
 	"github.com/hashicorp/hcl/v2"
 	"github.com/terraform-linters/tflint-plugin-sdk/logger"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
@@ -17,6 +19,10 @@ func checkTypeEcho(runner tflint.Runner,
 	rule *Rule, defRange hcl.Range,
 	typ string, name string, synonym string) {
 
+	if te := rule.Config.TypeEcho; te != nil && te.Enabled != nil && !*te.Enabled {
+		return
+	}
+
 	// Assume there is no echo.
 	echo := false
 
@@ -66,8 +72,12 @@ func checkTypeEcho(runner tflint.Runner,
 	}
 
 	if echo {
-		if err := runner.EmitIssue(
-			rule,
+		level := ""
+		if rule.Config.TypeEcho != nil {
+			level = rule.Config.TypeEcho.Level
+		}
+		if err := rulehelper.SubRule(runner, "type_echo").EmitIssue(
+			rulehelper.WithLevel(rule, level),
 			fmt.Sprintf("Avoid echoing type \"%s\"%s in label \"%s\".", typ, synonymText, name),
 			defRange,
 		); err != nil {
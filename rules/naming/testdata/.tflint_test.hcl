@@ -1,25 +1,31 @@
 # Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
 # SPDX-License-Identifier: Apache-2.0
 
-rule "eos_naming" {}
+rule "eos_naming" {
+  enabled = true
+}
 
 rule "eos_naming_disabled" {
   enabled = false
 }
 
 rule "eos_naming_negative_length" {
+  enabled = true
   length = -5
 }
 
 rule "eos_naming_noshout" {
+  enabled = true
   shout = false
 }
 
 rule "eos_naming_nosnake" {
+  enabled = true
   snake = false
 }
 
 rule "eos_naming_type_echo" {
+  enabled = true
   type_echo {
     enabled = true
     synonyms = {
@@ -29,15 +35,34 @@ rule "eos_naming_type_echo" {
 }
 
 rule "eos_naming_type_echo_disabled" {
+  enabled = true
   type_echo {
     enabled = false
   }
 }
 
 rule "eos_naming_type_echo_custom" {
+  enabled = true
   type_echo {
     synonyms = {
       "aws_s3_bucket" = ["pail"]
     }
   }
 }
+
+rule "eos_naming_levels" {
+  enabled = true
+  shout = "notice"
+  type_echo {
+    level = "error"
+  }
+}
+
+rule "eos_naming_override" {
+  enabled = true
+  override {
+    files  = ["modules/legacy/**"]
+    length = 24
+    snake  = false
+  }
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package outputs
+
+import (
+	"path/filepath"
+
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// outputsConfig represents the configuration for the OutputsRule.
+type outputsConfig struct {
+	Description   rulehelper.SubCheck `hclext:"description,optional" hcl:"description,optional"`
+	Enabled       *bool               `hclext:"enabled,optional" hcl:"enabled,optional"`
+	Level         string              `hclext:"level,optional" hcl:"level,optional"`
+	Name          rulehelper.SubCheck `hclext:"name,optional" hcl:"name,optional"`
+	Sensitive     rulehelper.SubCheck `hclext:"sensitive,optional" hcl:"sensitive,optional"`
+	WholeResource rulehelper.SubCheck `hclext:"whole_resource,optional" hcl:"whole_resource,optional"`
+}
+
+// defaultOutputsConfig is the default configuration for the OutputsRule.
+var defaultOutputsConfig = outputsConfig{
+	Enabled: rulehelper.BoolPtr(true),
+	Level:   "warning",
+}
+
+// outputBlocks are the blocks the rule walks.
+var outputBlocks = []rulehelper.BlockDef{
+	{Typ: "output", Labels: []string{"name"}},
+}
+
+// outputSchema holds the arguments of an output block the checks look at.
+var outputSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{
+		{Name: "description"},
+		{Name: "sensitive"},
+		{Name: "value"},
+	},
+}
+
+// variableSchema holds the argument of a variable block that marks it
+// sensitive.
+var variableSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{
+		{Name: "sensitive"},
+	},
+}
+
+// Rule checks the declarations of outputs.
+type Rule struct {
+	tflint.DefaultRule
+	Config outputsConfig
+	// RuleName is the rule block name to load from the config file. If empty,
+	// defaults to "eos_outputs".
+	RuleName string
+	// overrides are the override blocks of the rule block, applied by ForFile.
+	overrides rulehelper.Overrides[outputsConfig]
+	// sensitiveVariables are the names of the variables declared sensitive, by
+	// module directory.
+	sensitiveVariables map[string]map[string]bool
+}
+
+// Check checks whether the rule conditions are met.
+func (r *Rule) Check(runner tflint.Runner) error {
+	ignores, overrides, err := rulehelper.BeginCheck(runner, r, &r.Config)
+	if err != nil || ignores == nil {
+		return err
+	}
+	r.overrides = overrides
+
+	// An output may return a variable declared in any file of its module, but
+	// not in those of another module linted along with it.
+	r.sensitiveVariables, err = findSensitiveVariables(ignores)
+	if err != nil {
+		return err
+	}
+
+	// Each check decides for itself whether it is switched on, as overrides
+	// can switch it on or off for some files.
+	if err := rulehelper.WalkBlockContents(ignores, outputBlocks, r,
+		checkDescription,
+		checkWholeResource,
+		checkSensitive,
+		checkName); err != nil {
+		return err
+	}
+
+	return ignores.ReportIgnores()
+}
+
+// findSensitiveVariables returns the names of the variables declared with
+// sensitive = true, keyed by the directory of their module. With local_modules
+// set, the files of local modules are linted along with the root, and each
+// declares its own variables.
+func findSensitiveVariables(runner tflint.Runner) (map[string]map[string]bool, error) {
+	files, err := runner.GetFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	sensitive := map[string]map[string]bool{}
+	for filename, file := range files {
+		for _, block := range rulehelper.Source(filename, file).Blocks() {
+			if block.Type != "variable" {
+				continue
+			}
+			content, _, _ := block.Body.PartialContent(variableSchema)
+			attr, exists := content.Attributes["sensitive"]
+			if !exists {
+				continue
+			}
+			if val, diags := attr.Expr.Value(nil); !diags.HasErrors() && val.RawEquals(cty.True) {
+				dir := filepath.Dir(filename)
+				if sensitive[dir] == nil {
+					sensitive[dir] = map[string]bool{}
+				}
+				sensitive[dir][block.Labels[0]] = true
+			}
+		}
+	}
+	return sensitive, nil
+}
+
+// outputArguments returns the arguments of an output block the checks look at.
+func outputArguments(block *hcl.Block) *hcl.BodyContent {
+	content, _, _ := block.Body.PartialContent(outputSchema)
+	return content
+}
+
+// NewOutputsRule returns a new rule.
+func NewOutputsRule() *Rule {
+	rule := &Rule{}
+	rule.Config = defaultOutputsConfig
+	return rule
+}
+
+// ForFile returns the rule with the config that applies to filename.
+func (r *Rule) ForFile(filename string) *Rule {
+	config, ok := r.overrides.Match(filename)
+	if !ok {
+		return r
+	}
+	fileRule := *r
+	fileRule.Config = config
+	return &fileRule
+}
+
+// Enabled returns whether the rule is enabled by default.
+func (r *Rule) Enabled() bool {
+	return r.Config.Enabled == nil || *r.Config.Enabled
+}
+
+// Link returns the rule reference link.
+func (r *Rule) Link() string {
+	return "https://github.com/tfctl/tflint-ruleset-elements-of-style/blob/main/docs/rules/eos_outputs.md"
+}
+
+// Name returns the rule name.
+func (r *Rule) Name() string {
+	if r.RuleName != "" {
+		return r.RuleName
+	}
+
+	return "eos_outputs"
+}
+
+// Severity returns the rule severity.
+func (r *Rule) Severity() tflint.Severity {
+	return rulehelper.ToSeverity(r.Config.Level)
+}
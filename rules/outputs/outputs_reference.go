@@ -0,0 +1,79 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package outputs
+
+import (
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+// Kinds of object a reference can address.
+const (
+	kindData     = "data"
+	kindLocal    = "local"
+	kindModule   = "module"
+	kindOther    = ""
+	kindResource = "resource"
+	kindVariable = "var"
+)
+
+// reference is a traversal split into the address of the object it refers to
+// and the path into that object, e.g. aws_vpc.main and .id.
+type reference struct {
+	kind    string
+	address hcl.Traversal
+	path    hcl.Traversal
+}
+
+// parseReference splits traversal into its address and path. The address of a
+// resource, data source or module call includes its instance key, if any.
+func parseReference(traversal hcl.Traversal) reference {
+	kind, length := kindResource, 2
+	switch traversal.RootName() {
+	case "data":
+		kind, length = kindData, 3
+	case "local":
+		kind = kindLocal
+	case "module":
+		kind = kindModule
+	case "var":
+		kind = kindVariable
+	case "count", "each", "path", "self", "terraform":
+		kind, length = kindOther, 1
+	}
+	if len(traversal) < length {
+		return reference{kind: kindOther, address: traversal}
+	}
+
+	switch kind {
+	case kindData, kindModule, kindResource:
+		if len(traversal) > length {
+			if _, ok := traversal[length].(hcl.TraverseIndex); ok {
+				length++
+			}
+		}
+	}
+	return reference{kind: kind, address: traversal[:length], path: traversal[length:]}
+}
+
+// valueReference returns the reference the value of an output consists of,
+// e.g. aws_vpc.main.id, and false if the value is anything else.
+func valueReference(attr *hcl.Attribute) (reference, bool) {
+	native, ok := rulehelper.NativeExpression(attr.Expr)
+	if !ok {
+		return reference{}, false
+	}
+	traversal, diags := hcl.AbsTraversalForExpr(native)
+	if diags.HasErrors() {
+		return reference{}, false
+	}
+	return parseReference(traversal), true
+}
+
+// traversalString returns traversal as written, e.g. aws_vpc.main.id.
+func traversalString(traversal hcl.Traversal) string {
+	return string(hclwrite.TokensForTraversal(traversal).Bytes())
+}
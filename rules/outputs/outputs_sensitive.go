@@ -0,0 +1,118 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package outputs
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// SensitiveMessage is the message emitted when an output returns a secret
+// without being marked sensitive. It takes the output name and the reference
+// to the secret.
+const SensitiveMessage = "Output '%s' returns %s and should set sensitive = true."
+
+// secretWords are the words that mark a name as holding a secret when the
+// name ends with them, e.g. db_password but not password_policy_id. The words
+// of the attributes providers keep secrets in, e.g. secret_string, are listed
+// too, as their last word says nothing of it.
+var secretWords = []string{
+	"access_key", "api_key", "credential", "passphrase", "password", "private_key", "secret", "token",
+	"private_key_openssh", "private_key_pem", "secret_binary", "secret_string",
+}
+
+// checkSensitive checks that an output returning a sensitive variable, or an
+// attribute named like a secret, says whether it is sensitive. Outputs setting
+// sensitive either way, or returning nonsensitive(...), have been decided on
+// and aren't reported.
+func checkSensitive(runner tflint.Runner, r *Rule, block *hcl.Block) {
+	if !r.Config.Sensitive.Enabled() {
+		return
+	}
+
+	args := outputArguments(block)
+	attr, exists := args.Attributes["value"]
+	if !exists {
+		return
+	}
+	if _, exists := args.Attributes["sensitive"]; exists {
+		return
+	}
+	if native, ok := rulehelper.NativeExpression(attr.Expr); ok {
+		if call, ok := native.(*hclsyntax.FunctionCallExpr); ok && call.Name == "nonsensitive" {
+			return
+		}
+	}
+
+	for _, traversal := range attr.Expr.Variables() {
+		if r.isSecret(parseReference(traversal), filepath.Dir(block.DefRange.Filename)) {
+			message := fmt.Sprintf(SensitiveMessage, block.Labels[0], traversalString(traversal))
+			rulehelper.EmitIssue(runner, r, "sensitive", r.Config.Sensitive.Level(), message, attr.Range)
+			return
+		}
+	}
+}
+
+// isSecret reports whether ref refers to a sensitive variable of the module in
+// dir, or a name in it other than the labels of a block looks like a secret.
+func (r *Rule) isSecret(ref reference, dir string) bool {
+	// The names of variables and locals are chosen for their values, unlike
+	// the labels of resources, data sources and module calls.
+	steps := ref.path
+	switch ref.kind {
+	case kindVariable:
+		if attr, ok := ref.address[1].(hcl.TraverseAttr); ok && r.sensitiveVariables[dir][attr.Name] {
+			return true
+		}
+		steps = append(hcl.Traversal{ref.address[1]}, steps...)
+	case kindLocal:
+		steps = append(hcl.Traversal{ref.address[1]}, steps...)
+	}
+
+	var names []string
+	for _, step := range steps {
+		if attr, ok := step.(hcl.TraverseAttr); ok {
+			names = append(names, attr.Name)
+		}
+	}
+
+	for _, name := range names {
+		if looksSecret(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// looksSecret reports whether a name ends with one of the secretWords, word
+// for word. Words are separated by underscores or hyphens, and the last one
+// may be plural, e.g. api_credentials.
+func looksSecret(name string) bool {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return r == '_' || r == '-'
+	})
+	if len(words) == 0 {
+		return false
+	}
+	singular := slices.Clone(words)
+	singular[len(singular)-1] = strings.TrimSuffix(singular[len(singular)-1], "s")
+
+	for _, secret := range secretWords {
+		suffix := strings.Split(secret, "_")
+		for _, candidate := range [][]string{words, singular} {
+			if len(candidate) >= len(suffix) && slices.Equal(candidate[len(candidate)-len(suffix):], suffix) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,288 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package outputs
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/testhelper"
+)
+
+func TestOutputs(t *testing.T) {
+	if !flag.Parsed() {
+		flag.Parse()
+	}
+
+	t.Run("Config", testOutputsConfig)
+	t.Run("ConfigErrors", testOutputsConfigErrors)
+
+	t.Run("Files", testOutputsFiles)
+	t.Run("JSON", testOutputsJSON)
+	t.Run("Levels", testOutputsLevels)
+	t.Run("Rule", testOutputsRule)
+}
+
+func testOutputsConfig(t *testing.T) {
+	cases := []testhelper.ConfigTestCase{
+		{
+			Name: "eos_outputs",
+			Want: defaultOutputsConfig,
+		},
+		{
+			Name: "eos_outputs_relaxed",
+			Want: func() outputsConfig {
+				cfg := defaultOutputsConfig
+				cfg.Description = "false"
+				cfg.Name = "false"
+				return cfg
+			}(),
+		},
+		{
+			Name: "eos_outputs_levels",
+			Want: func() outputsConfig {
+				cfg := defaultOutputsConfig
+				cfg.WholeResource = "error"
+				cfg.Sensitive = "notice"
+				return cfg
+			}(),
+		},
+	}
+
+	testhelper.ConfigTestRunner(t, defaultOutputsConfig, cases)
+}
+
+func testOutputsConfigErrors(t *testing.T) {
+	cases := []testhelper.ConfigErrorTestCase{
+		{
+			Name: "eos_outputs_bad_level",
+			Want: `rule "eos_outputs_bad_level": "name" must be true, false or a level (notice, warning, error), not "loud"`,
+		},
+		{
+			Name: "eos_outputs_typo",
+			Want: `rule "eos_outputs_typo": unknown option "sensitve" at testdata/.tflint_test.hcl:27,3-11. Did you mean "sensitive"? Valid options: description, enabled, level, name, sensitive, whole_resource, override { files, ... }.`,
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewOutputsRule() }
+	testhelper.ConfigErrorTestRunner(t, ruleFactory, cases)
+}
+
+func testOutputsRule(t *testing.T) {
+	content, _ := os.ReadFile("./testdata/outputs_test.tf")
+
+	cases := []testhelper.RuleTestCase{
+		{
+			Name:    "eos_outputs",
+			Content: string(content),
+			Want: []string{
+				fmt.Sprintf(MissingDescriptionMessage, "no_description"),
+				fmt.Sprintf(WholeResourceMessage, "whole_vpc", "aws_vpc.main"),
+				fmt.Sprintf(WholeResourceMessage, "whole_instance", "aws_instance.web[0]"),
+				fmt.Sprintf(WholeResourceMessage, "whole_ami", "data.aws_ami.ubuntu"),
+				fmt.Sprintf(SensitiveMessage, "password", "var.db_password"),
+				fmt.Sprintf(SensitiveMessage, "connection", "var.db_password"),
+				fmt.Sprintf(SensitiveMessage, "api_credentials", "var.api_key"),
+				fmt.Sprintf(SensitiveMessage, "admin_password", "aws_db_instance.main.password"),
+				fmt.Sprintf(NameMessage, "vpc", "id", "aws_vpc.main", "vpc_id"),
+				fmt.Sprintf(NameMessage, "endpoint", "address", "module.db", "endpoint_address"),
+			},
+		},
+		{
+			Name:    "eos_outputs_relaxed",
+			Content: string(content),
+			Want: []string{
+				fmt.Sprintf(WholeResourceMessage, "whole_vpc", "aws_vpc.main"),
+				fmt.Sprintf(WholeResourceMessage, "whole_instance", "aws_instance.web[0]"),
+				fmt.Sprintf(WholeResourceMessage, "whole_ami", "data.aws_ami.ubuntu"),
+				fmt.Sprintf(SensitiveMessage, "password", "var.db_password"),
+				fmt.Sprintf(SensitiveMessage, "connection", "var.db_password"),
+				fmt.Sprintf(SensitiveMessage, "api_credentials", "var.api_key"),
+				fmt.Sprintf(SensitiveMessage, "admin_password", "aws_db_instance.main.password"),
+				// The annotation names outputs.whole_resource, not this rule.
+				fmt.Sprintf(WholeResourceMessage, "whole_bucket", "aws_s3_bucket.logs"),
+			},
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewOutputsRule() }
+	testhelper.RuleTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "outputs_test.tf")
+}
+
+func testOutputsFiles(t *testing.T) {
+	cases := []testhelper.FilesTestCase{
+		{
+			Name: "eos_outputs",
+			Files: map[string]string{
+				"variables.tf": `variable "db_password" {
+  description = "The password of the database."
+  type        = string
+  sensitive   = true
+}
+`,
+				"outputs.tf": `output "db" {
+  description = "The connection details of the database."
+  value       = { user = "admin", pass = var.db_password }
+}
+`,
+			},
+			Want: []string{
+				fmt.Sprintf(SensitiveMessage, "db", "var.db_password"),
+			},
+		},
+		{
+			// A sensitive variable of a local module doesn't make the root
+			// module's variable of the same name sensitive.
+			Name: "eos_outputs",
+			Files: map[string]string{
+				"variables.tf": `variable "db" {
+  description = "The name of the database."
+  type        = string
+}
+`,
+				"outputs.tf": `output "db" {
+  description = "The name of the database."
+  value       = var.db
+}
+`,
+				"child/variables.tf": `variable "db" {
+  description = "The connection string of the database."
+  type        = string
+  sensitive   = true
+}
+`,
+				"child/outputs.tf": `output "db" {
+  description = "The connection string of the database."
+  value       = var.db
+}
+`,
+			},
+			Want: []string{
+				fmt.Sprintf(SensitiveMessage, "db", "var.db"),
+			},
+		},
+		{
+			// Only names ending with a secret word, word for word, look like
+			// secrets.
+			Name: "eos_outputs",
+			Files: map[string]string{
+				"outputs.tf": `output "secret_arn" {
+  description = "The ARN of the secret."
+  value       = aws_secretsmanager_secret.main.secret_arn
+}
+
+output "token_ttl" {
+  description = "The lifetime of the tokens."
+  value       = var.token_ttl
+}
+
+output "tokenizer" {
+  description = "The tokenizer of the search index."
+  value       = local.tokenizer
+}
+
+output "password_policy_id" {
+  description = "The ID of the password policy."
+  value       = aws_iam_account_password_policy.main.password_policy_id
+}
+
+output "client_secret" {
+  description = "The secret of the client."
+  value       = azuread_application_password.main.client_secret
+}
+
+output "secret_string" {
+  description = "The value of the secret."
+  value       = aws_secretsmanager_secret_version.main.secret_string
+}
+
+output "tokens" {
+  description = "The tokens of the API."
+  value       = local.api-tokens
+}
+`,
+			},
+			Want: []string{
+				fmt.Sprintf(SensitiveMessage, "client_secret", "azuread_application_password.main.client_secret"),
+				fmt.Sprintf(SensitiveMessage, "secret_string", "aws_secretsmanager_secret_version.main.secret_string"),
+				fmt.Sprintf(SensitiveMessage, "tokens", "local.api-tokens"),
+			},
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewOutputsRule() }
+	testhelper.FilesTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases)
+}
+
+func testOutputsLevels(t *testing.T) {
+	cases := []testhelper.SeverityTestCase{
+		{
+			Name: "eos_outputs_levels",
+			Content: `output "vpc" {
+  description = "The VPC."
+  value       = aws_vpc.main
+}
+
+output "token" {
+  description = "The token of the API."
+  value       = aws_api_key.main.token
+}
+`,
+			Want: map[string]tflint.Severity{
+				fmt.Sprintf(WholeResourceMessage, "vpc", "aws_vpc.main"):         tflint.ERROR,
+				fmt.Sprintf(SensitiveMessage, "token", "aws_api_key.main.token"): tflint.NOTICE,
+			},
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewOutputsRule() }
+	testhelper.SeverityTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "outputs_levels.tf")
+}
+
+func testOutputsJSON(t *testing.T) {
+	cases := []testhelper.RuleTestCase{
+		{
+			Name: "eos_outputs",
+			Content: `{
+  "variable": {
+    "db_password": {
+      "description": "The password of the database.",
+      "type": "string",
+      "sensitive": true
+    }
+  },
+  "output": {
+    "vpc": {
+      "value": "${aws_vpc.main}"
+    },
+    "password": {
+      "description": "The password of the database.",
+      "value": "${var.db_password}"
+    },
+    "subnet": {
+      "description": "The subnet.",
+      "value": "${aws_subnet.main.id}"
+    },
+    "vpc_id": {
+      "description": "The ID of the VPC.",
+      "value": "${aws_vpc.main.id}"
+    }
+  }
+}
+`,
+			Want: []string{
+				fmt.Sprintf(MissingDescriptionMessage, "vpc"),
+				fmt.Sprintf(WholeResourceMessage, "vpc", "aws_vpc.main"),
+				fmt.Sprintf(SensitiveMessage, "password", "var.db_password"),
+				fmt.Sprintf(NameMessage, "subnet", "id", "aws_subnet.main", "subnet_id"),
+			},
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewOutputsRule() }
+	testhelper.RuleTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "outputs_test.tf.json")
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package outputs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// Messages emitted by the value checks. Each takes the output name first.
+const (
+	MissingDescriptionMessage = "Output '%s' should have a description."
+	WholeResourceMessage      = "Output '%s' should return attributes of %s, not the whole object."
+	NameMessage               = "Output '%s' returns the %s of %s and should be named for it, e.g. '%s'."
+)
+
+// checkDescription checks that the output has a description.
+func checkDescription(runner tflint.Runner, r *Rule, block *hcl.Block) {
+	if !r.Config.Description.Enabled() {
+		return
+	}
+
+	if _, exists := outputArguments(block).Attributes["description"]; !exists {
+		message := fmt.Sprintf(MissingDescriptionMessage, block.Labels[0])
+		rulehelper.EmitIssue(runner, r, "description", r.Config.Description.Level(), message, rulehelper.DefRange(block))
+	}
+}
+
+// checkWholeResource checks that the output doesn't return a resource or data
+// source as a whole. Doing so couples callers to every attribute of it, and
+// hides which of them the module means to expose.
+func checkWholeResource(runner tflint.Runner, r *Rule, block *hcl.Block) {
+	if !r.Config.WholeResource.Enabled() {
+		return
+	}
+
+	attr, exists := outputArguments(block).Attributes["value"]
+	if !exists {
+		return
+	}
+	ref, ok := valueReference(attr)
+	if !ok || len(ref.path) > 0 || (ref.kind != kindResource && ref.kind != kindData) {
+		return
+	}
+
+	message := fmt.Sprintf(WholeResourceMessage, block.Labels[0], traversalString(ref.address))
+	rulehelper.EmitIssue(runner, r, "whole_resource", r.Config.WholeResource.Level(), message, attr.Range)
+}
+
+// checkName checks that an output returning an attribute of a resource, data
+// source or module call is named for that attribute, e.g. vpc_id rather than
+// vpc for aws_vpc.main.id.
+func checkName(runner tflint.Runner, r *Rule, block *hcl.Block) {
+	if !r.Config.Name.Enabled() {
+		return
+	}
+
+	attr, exists := outputArguments(block).Attributes["value"]
+	if !exists {
+		return
+	}
+	ref, ok := valueReference(attr)
+	if !ok || len(ref.path) == 0 || (ref.kind != kindResource && ref.kind != kindData && ref.kind != kindModule) {
+		return
+	}
+	step, ok := ref.path[len(ref.path)-1].(hcl.TraverseAttr)
+	if !ok {
+		return
+	}
+
+	name := block.Labels[0]
+	if namedFor(name, step.Name) {
+		return
+	}
+
+	suggestion := name + "_" + step.Name
+	if strings.HasPrefix(step.Name, name+"_") {
+		suggestion = step.Name
+	}
+	message := fmt.Sprintf(NameMessage, name, step.Name, traversalString(ref.address), suggestion)
+	rulehelper.EmitIssue(runner, r, "name", r.Config.Name.Level(), message, rulehelper.DefRange(block))
+}
+
+// namedFor reports whether the words of name include attribute, in the
+// singular or plural.
+func namedFor(name string, attribute string) bool {
+	words := "_" + name + "_"
+	return strings.Contains(words, "_"+attribute+"_") || strings.Contains(words, "_"+attribute+"s_")
+}
@@ -0,0 +1,28 @@
+# Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+# SPDX-License-Identifier: Apache-2.0
+
+rule "eos_outputs" {
+  enabled = true
+}
+
+rule "eos_outputs_relaxed" {
+  enabled     = true
+  description = false
+  name        = false
+}
+
+rule "eos_outputs_levels" {
+  enabled        = true
+  whole_resource = "error"
+  sensitive      = "notice"
+}
+
+rule "eos_outputs_bad_level" {
+  enabled = true
+  name    = "loud"
+}
+
+rule "eos_outputs_typo" {
+  enabled = true
+  sensitve = false
+}
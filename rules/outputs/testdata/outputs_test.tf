@@ -0,0 +1,110 @@
+# Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+# SPDX-License-Identifier: Apache-2.0
+
+variable "db_password" {
+  description = "The password of the database."
+  type        = string
+  sensitive   = true
+}
+
+variable "api_key" {
+  description = "The key of the API."
+  type        = string
+}
+
+# #########
+# Tests that will emit issues.
+
+output "no_description" {
+  value = var.region
+}
+
+output "whole_vpc" {
+  description = "The VPC."
+  value       = aws_vpc.main
+}
+
+output "whole_instance" {
+  description = "The first instance."
+  value       = aws_instance.web[0]
+}
+
+output "whole_ami" {
+  description = "The AMI."
+  value       = data.aws_ami.ubuntu
+}
+
+output "password" {
+  description = "The password of the database."
+  value       = var.db_password
+}
+
+output "connection" {
+  description = "The connection string of the database."
+  value       = "postgres://admin:${var.db_password}@${aws_db_instance.main.address}"
+}
+
+output "api_credentials" {
+  description = "The key of the API."
+  value       = var.api_key
+}
+
+output "admin_password" {
+  description = "The admin password of the database."
+  value       = aws_db_instance.main.password
+}
+
+output "vpc" {
+  description = "The VPC."
+  value       = aws_vpc.main.id
+}
+
+output "endpoint" {
+  description = "The database endpoint."
+  value       = module.db.address
+}
+
+# #########
+# Tests that will not emit issues.
+
+output "vpc_id" {
+  description = "The ID of the VPC."
+  value       = aws_vpc.main.id
+}
+
+output "subnet_ids" {
+  description = "The IDs of the subnets."
+  value       = aws_subnet.private[*].id
+}
+
+output "web_id" {
+  description = "The ID of the first instance."
+  value       = aws_instance.web[0].id
+}
+
+output "db_password" {
+  description = "The password of the database."
+  value       = var.db_password
+  sensitive   = true
+}
+
+output "token_hint" {
+  description = "The last characters of the token."
+  value       = nonsensitive(substr(var.db_password, 0, 2))
+}
+
+output "region" {
+  description = "The region deployed to."
+  value       = var.region
+}
+
+output "tags" {
+  description = "The tags of the VPC."
+  value       = aws_vpc.main.tags["Name"]
+}
+
+# eos-ignore: outputs.whole_resource -- callers use most attributes
+output "whole_bucket" {
+  description = "The bucket."
+  value       = aws_s3_bucket.logs
+}
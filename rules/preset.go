@@ -0,0 +1,101 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+
+	blockorder "github.com/tfctl/tflint-ruleset-elements-of-style/rules/block_order"
+	"github.com/tfctl/tflint-ruleset-elements-of-style/rules/comment"
+	deathmask "github.com/tfctl/tflint-ruleset-elements-of-style/rules/death_mask"
+	"github.com/tfctl/tflint-ruleset-elements-of-style/rules/dry"
+	filelayout "github.com/tfctl/tflint-ruleset-elements-of-style/rules/file_layout"
+	"github.com/tfctl/tflint-ruleset-elements-of-style/rules/heredoc"
+	"github.com/tfctl/tflint-ruleset-elements-of-style/rules/hungarian"
+	linelength "github.com/tfctl/tflint-ruleset-elements-of-style/rules/line_length"
+	"github.com/tfctl/tflint-ruleset-elements-of-style/rules/meta"
+	"github.com/tfctl/tflint-ruleset-elements-of-style/rules/naming"
+	"github.com/tfctl/tflint-ruleset-elements-of-style/rules/outputs"
+	"github.com/tfctl/tflint-ruleset-elements-of-style/rules/prose"
+	"github.com/tfctl/tflint-ruleset-elements-of-style/rules/reminder"
+	"github.com/tfctl/tflint-ruleset-elements-of-style/rules/size"
+	"github.com/tfctl/tflint-ruleset-elements-of-style/rules/spacing"
+	"github.com/tfctl/tflint-ruleset-elements-of-style/rules/spelling"
+	"github.com/tfctl/tflint-ruleset-elements-of-style/rules/variables"
+)
+
+// The rule instances are shared across presets. A rule loads its own config
+// in Check(), so each rule must exist exactly once regardless of how many
+// presets list it.
+var (
+	blockOrderRule = blockorder.NewBlockOrderRule()
+	commentsRule   = comment.NewCommentsRule()
+	deathMaskRule  = deathmask.NewDeathMaskRule()
+	dryRule        = dry.NewDryRule()
+	fileLayoutRule = filelayout.NewFileLayoutRule()
+	heredocRule    = heredoc.NewHeredocRule()
+	hungarianRule  = hungarian.NewHungarianRule()
+	lineLengthRule = linelength.NewLineLengthRule()
+	metaRule       = meta.NewMetaRule()
+	namingRule     = naming.NewNamingRule()
+	outputsRule    = outputs.NewOutputsRule()
+	proseRule      = prose.NewProseRule()
+	reminderRule   = reminder.NewReminderRule()
+	sizeRule       = size.NewSizeRule()
+	spacingRule    = spacing.NewSpacingRule()
+	spellingRule   = spelling.NewSpellingRule()
+	variablesRule  = variables.NewVariablesRule()
+)
+
+// recommendedRules are the low-noise rules that most codebases can adopt
+// without a flood of findings.
+var recommendedRules = []tflint.Rule{
+	commentsRule,
+	deathMaskRule,
+	heredocRule,
+	metaRule,
+	namingRule,
+}
+
+// strictRules adds the rules that tend to be noisy on mature codebases.
+var strictRules = append(append([]tflint.Rule{}, recommendedRules...),
+	blockOrderRule,
+	dryRule,
+	fileLayoutRule,
+	hungarianRule,
+	lineLengthRule,
+	outputsRule,
+	proseRule,
+	reminderRule,
+	sizeRule,
+	spacingRule,
+	spellingRule,
+	variablesRule,
+)
+
+// PresetRules maps each preset name to the rules it enables. The "all" preset
+// is also the authoritative list of rules served by the plugin.
+var PresetRules = map[string][]tflint.Rule{
+	"all": {
+		blockOrderRule,
+		commentsRule,
+		deathMaskRule,
+		dryRule,
+		fileLayoutRule,
+		heredocRule,
+		hungarianRule,
+		lineLengthRule,
+		metaRule,
+		namingRule,
+		outputsRule,
+		proseRule,
+		reminderRule,
+		sizeRule,
+		spacingRule,
+		spellingRule,
+		variablesRule,
+	},
+	"recommended": recommendedRules,
+	"strict":      strictRules,
+}
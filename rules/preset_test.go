@@ -0,0 +1,38 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package rules
+
+import (
+	"testing"
+)
+
+func TestPresetRules(t *testing.T) {
+	for _, name := range []string{"all", "recommended", "strict"} {
+		if _, ok := PresetRules[name]; !ok {
+			t.Errorf("Missing preset %q", name)
+		}
+	}
+
+	all := make(map[string]bool)
+	for _, rule := range PresetRules["all"] {
+		if all[rule.Name()] {
+			t.Errorf("Rule %s is listed more than once in preset \"all\"", rule.Name())
+		}
+		all[rule.Name()] = true
+	}
+
+	// Every preset must be a subset of "all", otherwise RuleSet.ApplyConfig will
+	// never enable the extra rules.
+	for preset, rules := range PresetRules {
+		for _, rule := range rules {
+			if !all[rule.Name()] {
+				t.Errorf("Rule %s in preset %q is not in preset \"all\"", rule.Name(), preset)
+			}
+		}
+	}
+
+	if len(PresetRules["recommended"]) >= len(PresetRules["strict"]) {
+		t.Errorf("Preset \"strict\" should enable more rules than \"recommended\"")
+	}
+}
@@ -0,0 +1,229 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package prose
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// Targets the prose of which can be checked.
+const (
+	targetComments     = "comments"
+	targetDescription  = "description"
+	targetErrorMessage = "error_message"
+)
+
+// proseTargets are the valid targets, in the order of their option values.
+var proseTargets = []string{targetComments, targetDescription, targetErrorMessage}
+
+// stringKinds name the string targets in messages.
+var stringKinds = map[string]string{
+	targetDescription:  "Description",
+	targetErrorMessage: "Error message",
+}
+
+// needlessWordsConfig represents the configuration for needless words checks.
+type needlessWordsConfig struct {
+	Enabled *bool  `hclext:"enabled,optional" hcl:"enabled,optional"`
+	Level   string `hclext:"level,optional" hcl:"level,optional"`
+	// Words are the words and phrases to omit. They replace the defaults.
+	Words []string `hclext:"words,optional" hcl:"words,optional"`
+}
+
+// passiveConfig represents the configuration for passive voice checks.
+type passiveConfig struct {
+	Enabled *bool  `hclext:"enabled,optional" hcl:"enabled,optional"`
+	Level   string `hclext:"level,optional" hcl:"level,optional"`
+	// Participles are the irregular past participles, those not ending in
+	// -ed. They replace the defaults.
+	Participles []string `hclext:"participles,optional" hcl:"participles,optional"`
+}
+
+// sentenceLengthConfig represents the configuration for sentence length
+// checks.
+type sentenceLengthConfig struct {
+	Enabled *bool  `hclext:"enabled,optional" hcl:"enabled,optional"`
+	Level   string `hclext:"level,optional" hcl:"level,optional"`
+	Max     *int   `hclext:"max,optional" hcl:"max,optional"`
+}
+
+// proseConfig represents the configuration for the ProseRule.
+type proseConfig struct {
+	DoubledWords   rulehelper.SubCheck   `hclext:"doubled_words,optional" hcl:"doubled_words,optional"`
+	Enabled        *bool                 `hclext:"enabled,optional" hcl:"enabled,optional"`
+	Level          string                `hclext:"level,optional" hcl:"level,optional"`
+	NeedlessWords  *needlessWordsConfig  `hclext:"needless_words,block" hcl:"needless_words,block"`
+	Passive        *passiveConfig        `hclext:"passive,block" hcl:"passive,block"`
+	Punctuation    rulehelper.SubCheck   `hclext:"punctuation,optional" hcl:"punctuation,optional"`
+	SentenceCase   rulehelper.SubCheck   `hclext:"sentence_case,optional" hcl:"sentence_case,optional"`
+	SentenceLength *sentenceLengthConfig `hclext:"sentence_length,block" hcl:"sentence_length,block"`
+	Targets        []string              `hclext:"targets,optional" hcl:"targets,optional"`
+}
+
+// Validate checks the values of the config.
+func (c *proseConfig) Validate() error {
+	for _, target := range c.Targets {
+		if !slices.Contains(proseTargets, target) {
+			return fmt.Errorf("\"targets\" must list %s, not %q", strings.Join(proseTargets, ", "), target)
+		}
+	}
+	if c.SentenceLength != nil && c.SentenceLength.Max != nil && *c.SentenceLength.Max < 1 {
+		return fmt.Errorf("\"sentence_length.max\" must be at least 1, not %d", *c.SentenceLength.Max)
+	}
+	return nil
+}
+
+// defaultProseConfig is the default configuration for the ProseRule. The word
+// lists and sentence length limit default to those in prose_words.go and
+// prose_sentences.go.
+var defaultProseConfig = proseConfig{
+	Enabled: rulehelper.BoolPtr(true),
+	Level:   "warning",
+}
+
+// Rule checks the English of descriptions, error messages and comments.
+type Rule struct {
+	tflint.DefaultRule
+	Config proseConfig
+	// RuleName is the rule block name to load from the config file. If empty,
+	// defaults to "eos_prose".
+	RuleName string
+	// overrides are the override blocks of the rule block, applied by ForFile.
+	overrides rulehelper.Overrides[proseConfig]
+}
+
+// passage is a piece of prose to check, along with where to report issues.
+type passage struct {
+	// kind names the passage in messages, e.g. "Description".
+	kind string
+	text string
+	rng  hcl.Range
+	// sentence says whether the passage should read as sentences, i.e. start
+	// with a capital letter and end with punctuation. Comments are often
+	// fragments, so they aren't held to that.
+	sentence bool
+}
+
+// Check checks whether the rule conditions are met.
+func (r *Rule) Check(runner tflint.Runner) error {
+	ignores, overrides, err := rulehelper.BeginCheck(runner, r, &r.Config)
+	if err != nil || ignores == nil {
+		return err
+	}
+	r.overrides = overrides
+
+	files, err := ignores.GetFiles()
+	if err != nil {
+		return err
+	}
+
+	for filename, file := range files {
+		// The strings are found by walking the native syntax tree, and JSON
+		// has no comments, so only native files are checked.
+		body, ok := file.Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		fileRule, enabled := rulehelper.ForFile(r, filename)
+		if !enabled {
+			continue
+		}
+
+		for _, p := range fileRule.passages(rulehelper.Source(filename, file), body) {
+			fileRule.checkWords(ignores, p)
+			fileRule.checkSentences(ignores, p)
+		}
+	}
+
+	return ignores.ReportIgnores()
+}
+
+// passages collects the prose of the targets of the rule in a file.
+func (r *Rule) passages(source *rulehelper.SourceFile, body *hclsyntax.Body) []passage {
+	targets := r.Config.Targets
+	if len(targets) == 0 {
+		targets = proseTargets
+	}
+
+	var passages []passage
+	var walk func(*hclsyntax.Body)
+	walk = func(b *hclsyntax.Body) {
+		for name, attr := range b.Attributes {
+			kind, ok := stringKinds[name]
+			if !ok || !slices.Contains(targets, name) {
+				continue
+			}
+			if text, ok := stringText(attr.Expr); ok {
+				passages = append(passages, passage{kind: kind, text: text, rng: attr.Expr.Range(), sentence: true})
+			}
+		}
+		for _, block := range b.Blocks {
+			walk(block.Body)
+		}
+	}
+	walk(body)
+
+	if slices.Contains(targets, targetComments) {
+		for _, group := range source.CommentMap().Groups() {
+			text := group.Text()
+			if rulehelper.IsAnnotation(text) {
+				continue
+			}
+			passages = append(passages, passage{kind: "Comment", text: text, rng: group.Range()})
+		}
+	}
+
+	return passages
+}
+
+// NewProseRule returns a new rule.
+func NewProseRule() *Rule {
+	rule := &Rule{}
+	rule.Config = defaultProseConfig
+	return rule
+}
+
+// ForFile returns the rule with the config that applies to filename.
+func (r *Rule) ForFile(filename string) *Rule {
+	config, ok := r.overrides.Match(filename)
+	if !ok {
+		return r
+	}
+	fileRule := *r
+	fileRule.Config = config
+	return &fileRule
+}
+
+// Enabled returns whether the rule is enabled by default.
+func (r *Rule) Enabled() bool {
+	return r.Config.Enabled == nil || *r.Config.Enabled
+}
+
+// Link returns the rule reference link.
+func (r *Rule) Link() string {
+	return "https://github.com/tfctl/tflint-ruleset-elements-of-style/blob/main/docs/rules/eos_prose.md"
+}
+
+// Name returns the rule name.
+func (r *Rule) Name() string {
+	if r.RuleName != "" {
+		return r.RuleName
+	}
+
+	return "eos_prose"
+}
+
+// Severity returns the rule severity.
+func (r *Rule) Severity() tflint.Severity {
+	return rulehelper.ToSeverity(r.Config.Level)
+}
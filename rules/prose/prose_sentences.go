@@ -0,0 +1,77 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package prose
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// Messages emitted by the sentence checks.
+const (
+	SentenceCaseMessage   = "%s should start with a capital letter."
+	PunctuationMessage    = "%s should end with a period, question mark or exclamation mark."
+	SentenceLengthMessage = "Sentence is %d words long (maximum %d)."
+)
+
+// defaultSentenceLength is the default maximum number of words in a sentence.
+const defaultSentenceLength = 40
+
+// checkSentences checks that a passage that should read as sentences starts
+// with a capital letter and ends with punctuation, and that none of its
+// sentences runs too long.
+func (r *Rule) checkSentences(runner tflint.Runner, p passage) {
+	text := strings.TrimSpace(p.text)
+	if text == "" {
+		return
+	}
+
+	if p.sentence && r.Config.SentenceCase.Enabled() {
+		if first := splitWords(text); len(first) > 0 && isLowerWord(first[0].text) && strings.HasPrefix(text, first[0].text) {
+			rulehelper.EmitIssue(runner, r, "sentence_case", r.Config.SentenceCase.Level(), fmt.Sprintf(SentenceCaseMessage, p.kind), p.rng)
+		}
+	}
+
+	// Closing brackets and quotes may follow the punctuation, and a text
+	// ending in an interpolation, or made of nothing else, can't be judged.
+	if end := strings.TrimRight(text, `)]"'`); p.sentence && r.Config.Punctuation.Enabled() &&
+		end != "" && !strings.HasSuffix(end, placeholder) && !strings.ContainsAny(end[len(end)-1:], ".?!") {
+		rulehelper.EmitIssue(runner, r, "punctuation", r.Config.Punctuation.Level(), fmt.Sprintf(PunctuationMessage, p.kind), p.rng)
+	}
+
+	if config := r.Config.SentenceLength; config == nil || isEnabled(config.Enabled) {
+		limit := defaultSentenceLength
+		level := ""
+		if config != nil {
+			level = config.Level
+			if config.Max != nil {
+				limit = *config.Max
+			}
+		}
+		for _, sentence := range splitSentences(text) {
+			if n := len(splitWords(sentence)); n > limit {
+				rulehelper.EmitIssue(runner, r, "sentence_length", level, fmt.Sprintf(SentenceLengthMessage, n, limit), p.rng)
+			}
+		}
+	}
+}
+
+// isLowerWord reports whether text is a plain word starting with a lowercase
+// letter. Identifiers such as subnet_ids or ipv4 are left alone, as their case
+// is not a matter of style.
+func isLowerWord(text string) bool {
+	first := []rune(text)[0]
+	return unicode.IsLower(first) && isAlpha(strings.ReplaceAll(text, "-", ""))
+}
+
+// isEnabled reports whether the enabled attribute of a sub-check block is
+// unset or true.
+func isEnabled(enabled *bool) bool {
+	return enabled == nil || *enabled
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package prose
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/testhelper"
+)
+
+func TestProse(t *testing.T) {
+	if !flag.Parsed() {
+		flag.Parse()
+	}
+
+	t.Run("Config", testProseConfig)
+	t.Run("ConfigErrors", testProseConfigErrors)
+
+	t.Run("Levels", testProseLevels)
+	t.Run("Rule", testProseRule)
+	t.Run("Words", testProseWords)
+}
+
+func testProseConfig(t *testing.T) {
+	max := 8
+	cases := []testhelper.ConfigTestCase{
+		{
+			Name: "eos_prose",
+			Want: defaultProseConfig,
+		},
+		{
+			Name: "eos_prose_strings",
+			Want: func() proseConfig {
+				cfg := defaultProseConfig
+				cfg.Targets = []string{"description", "error_message"}
+				return cfg
+			}(),
+		},
+		{
+			Name: "eos_prose_words",
+			Want: func() proseConfig {
+				cfg := defaultProseConfig
+				cfg.NeedlessWords = &needlessWordsConfig{Words: []string{"utilize", "leverage"}, Level: "error"}
+				cfg.Passive = &passiveConfig{Enabled: func() *bool { b := false; return &b }()}
+				cfg.SentenceLength = &sentenceLengthConfig{Max: &max}
+				return cfg
+			}(),
+		},
+	}
+
+	testhelper.ConfigTestRunner(t, defaultProseConfig, cases)
+}
+
+func testProseConfigErrors(t *testing.T) {
+	cases := []testhelper.ConfigErrorTestCase{
+		{
+			Name: "eos_prose_bad_target",
+			Want: `rule "eos_prose_bad_target": "targets" must list comments, description, error_message, not "heredoc"`,
+		},
+		{
+			Name: "eos_prose_bad_max",
+			Want: `rule "eos_prose_bad_max": "sentence_length.max" must be at least 1, not 0`,
+		},
+		{
+			Name: "eos_prose_typo",
+			Want: `rule "eos_prose_typo": unknown option "target" at testdata/.tflint_test.hcl:47,3-9. Did you mean "targets"? Valid options: doubled_words, enabled, level, needless_words { enabled, level, words }, passive { enabled, level, participles }, punctuation, sentence_case, sentence_length { enabled, level, max }, targets, override { files, ... }.`,
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewProseRule() }
+	testhelper.ConfigErrorTestRunner(t, ruleFactory, cases)
+}
+
+func testProseRule(t *testing.T) {
+	content, _ := os.ReadFile("./testdata/prose_test.tf")
+
+	strings := []string{
+		fmt.Sprintf(NeedlessWordsMessage, "in order to"),
+		fmt.Sprintf(NeedlessWordsMessage, "very"),
+		fmt.Sprintf(PassiveMessage, "is rotated"),
+		fmt.Sprintf(PassiveMessage, "was written"),
+		fmt.Sprintf(DoubledWordsMessage, "the"),
+		fmt.Sprintf(SentenceCaseMessage, "Description"),
+		fmt.Sprintf(SentenceCaseMessage, "Error message"),
+		fmt.Sprintf(PunctuationMessage, "Description"),
+		fmt.Sprintf(PunctuationMessage, "Error message"),
+		fmt.Sprintf(SentenceLengthMessage, 41, 40),
+	}
+
+	cases := []testhelper.RuleTestCase{
+		{
+			Name:    "eos_prose",
+			Content: string(content),
+			Want: append(append([]string{}, strings...),
+				fmt.Sprintf(NeedlessWordsMessage, "basically"),
+				fmt.Sprintf(DoubledWordsMessage, "the"),
+			),
+		},
+		{
+			Name:    "eos_prose_strings",
+			Content: string(content),
+			Want: append(append([]string{}, strings...),
+				// The annotation names prose.passive, not this rule.
+				fmt.Sprintf(PassiveMessage, "is rotated"),
+			),
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewProseRule() }
+	testhelper.RuleTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "prose_test.tf")
+}
+
+func testProseWords(t *testing.T) {
+	content, _ := os.ReadFile("./testdata/prose_test.tf")
+
+	cases := []testhelper.RuleTestCase{
+		{
+			Name:    "eos_prose_words",
+			Content: string(content),
+			Want: []string{
+				fmt.Sprintf(NeedlessWordsMessage, "leverage"),
+				fmt.Sprintf(DoubledWordsMessage, "the"),
+				fmt.Sprintf(DoubledWordsMessage, "the"),
+				fmt.Sprintf(SentenceCaseMessage, "Description"),
+				fmt.Sprintf(SentenceCaseMessage, "Error message"),
+				fmt.Sprintf(PunctuationMessage, "Description"),
+				fmt.Sprintf(PunctuationMessage, "Error message"),
+				fmt.Sprintf(SentenceLengthMessage, 12, 8),
+				fmt.Sprintf(SentenceLengthMessage, 12, 8),
+				fmt.Sprintf(SentenceLengthMessage, 41, 8),
+				fmt.Sprintf(SentenceLengthMessage, 9, 8),
+				fmt.Sprintf(SentenceLengthMessage, 9, 8),
+			},
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewProseRule() }
+	testhelper.RuleTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "prose_test.tf")
+}
+
+func testProseLevels(t *testing.T) {
+	cases := []testhelper.SeverityTestCase{
+		{
+			Name: "eos_prose_levels",
+			Content: `variable "region" {
+  description = "The the region to deploy to"
+  type        = string
+}
+`,
+			Want: map[string]tflint.Severity{
+				fmt.Sprintf(DoubledWordsMessage, "the"):        tflint.ERROR,
+				fmt.Sprintf(PunctuationMessage, "Description"): tflint.NOTICE,
+			},
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewProseRule() }
+	testhelper.SeverityTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "prose_levels.tf")
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package prose
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// placeholder stands in for an interpolation in the text of a string. It is
+// neither a letter nor a space, so the words on either side of it aren't
+// adjacent.
+const placeholder = "\x00"
+
+// stringText returns the text of a string, with each interpolation or
+// directive replaced by placeholder. It returns false if expr isn't a string.
+func stringText(expr hclsyntax.Expression) (string, bool) {
+	switch expr := expr.(type) {
+	case *hclsyntax.TemplateExpr:
+		var text strings.Builder
+		for _, part := range expr.Parts {
+			if literal, ok := part.(*hclsyntax.LiteralValueExpr); ok && literal.Val.Type() == cty.String {
+				text.WriteString(literal.Val.AsString())
+				continue
+			}
+			text.WriteString(placeholder)
+		}
+		return text.String(), true
+	case *hclsyntax.LiteralValueExpr:
+		if expr.Val.Type() == cty.String && !expr.Val.IsNull() {
+			return expr.Val.AsString(), true
+		}
+	}
+	return "", false
+}
+
+// word is a word of a text.
+type word struct {
+	text string
+	// adjacent says only spaces separate the word from the one before it.
+	adjacent bool
+}
+
+// isWordRune reports whether r belongs to a word. Underscores and digits keep
+// identifiers such as subnet_ids in one piece.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '\''
+}
+
+// splitWords splits text into words. Hyphens and apostrophes within a word,
+// as in "read-only" and "module's", don't split it.
+func splitWords(text string) []word {
+	var words []word
+	runes := []rune(text)
+	adjacent := false
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		if !isWordRune(r) {
+			// A hyphen between letters joins them.
+			if r == '-' && i > 0 && i+1 < len(runes) && isWordRune(runes[i-1]) && isWordRune(runes[i+1]) && len(words) > 0 {
+				j := i + 1
+				for j < len(runes) && isWordRune(runes[j]) {
+					j++
+				}
+				words[len(words)-1].text += string(runes[i:j])
+				i = j
+				continue
+			}
+			adjacent = adjacent && unicode.IsSpace(r)
+			i++
+			continue
+		}
+
+		j := i
+		for j < len(runes) && isWordRune(runes[j]) {
+			j++
+		}
+		text := strings.Trim(string(runes[i:j]), "'")
+		if text != "" {
+			words = append(words, word{text: text, adjacent: adjacent && len(words) > 0})
+		}
+		adjacent = true
+		i = j
+	}
+	return words
+}
+
+// splitSentences splits text into sentences. A sentence ends with a period,
+// question mark or exclamation mark followed by a space, or with a blank line.
+func splitSentences(text string) []string {
+	var sentences []string
+	runes := []rune(text)
+	start := 0
+	for i, r := range runes {
+		end := false
+		switch {
+		case r == '.' || r == '?' || r == '!':
+			end = i+1 == len(runes) || unicode.IsSpace(runes[i+1])
+		case r == '\n':
+			end = i+1 < len(runes) && runes[i+1] == '\n'
+		}
+		if end {
+			if sentence := strings.TrimSpace(string(runes[start : i+1])); sentence != "" {
+				sentences = append(sentences, sentence)
+			}
+			start = i + 1
+		}
+	}
+	if sentence := strings.TrimSpace(string(runes[start:])); sentence != "" {
+		sentences = append(sentences, sentence)
+	}
+	return sentences
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package prose
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSplitWords(t *testing.T) {
+	cases := []struct {
+		text string
+		want string
+	}{
+		{"The the region.", "The +the +region"},
+		{"The region, the zone.", "The +region the +zone"},
+		{"A read-only module's 'output'.", "A +read-only +module's +output"},
+		{"The " + placeholder + " the subnet_ids", "The the +subnet_ids"},
+		{"us-east-1 and\nus-west-2", "us-east-1 +and +us-west-2"},
+	}
+
+	for _, tc := range cases {
+		var got []string
+		for _, w := range splitWords(tc.text) {
+			if w.adjacent {
+				got = append(got, "+"+w.text)
+			} else {
+				got = append(got, w.text)
+			}
+		}
+		if strings.Join(got, " ") != tc.want {
+			t.Errorf("splitWords(%q) = %q, want %q", tc.text, strings.Join(got, " "), tc.want)
+		}
+	}
+}
+
+func TestSplitSentences(t *testing.T) {
+	cases := []struct {
+		text string
+		want string
+	}{
+		{"One. Two? Three!", "One.|Two?|Three!"},
+		{"See e.g. the docs at example.com.", "See e.g.|the docs at example.com."},
+		{"A heading\n\nThe body", "A heading|The body"},
+		{"Wrapped\nacross lines.", "Wrapped\nacross lines."},
+	}
+
+	for _, tc := range cases {
+		if got := strings.Join(splitSentences(tc.text), "|"); got != tc.want {
+			t.Errorf("splitSentences(%q) = %q, want %q", tc.text, got, tc.want)
+		}
+	}
+}
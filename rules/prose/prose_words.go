@@ -0,0 +1,155 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package prose
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// Messages emitted by the word checks.
+const (
+	NeedlessWordsMessage = "Omit needless words: %q."
+	PassiveMessage       = "Prefer the active voice to %q."
+	DoubledWordsMessage  = "Remove the doubled word %q."
+)
+
+// defaultNeedlessWords are the words and phrases that add length but no
+// meaning.
+var defaultNeedlessWords = []string{
+	"actually",
+	"at this point in time",
+	"basically",
+	"due to the fact that",
+	"for the purpose of",
+	"in order to",
+	"it should be noted that",
+	"literally",
+	"needless to say",
+	"really",
+	"the fact that",
+	"very",
+}
+
+// defaultParticiples are the common irregular past participles. Regular ones,
+// ending in -ed, are recognized by their ending.
+var defaultParticiples = []string{
+	"begun", "bound", "brought", "built", "bought", "caught", "chosen", "done",
+	"drawn", "driven", "fed", "found", "forgotten", "frozen", "given", "gone",
+	"grown", "held", "hidden", "kept", "known", "laid", "led", "left", "lost",
+	"made", "meant", "met", "paid", "put", "read", "run", "said", "seen", "sent",
+	"set", "shown", "shut", "sold", "spent", "split", "taken", "taught", "told",
+	"thought", "thrown", "understood", "won", "written",
+}
+
+// beVerbs are the forms of "to be" that, followed by a past participle, make
+// the passive voice.
+var beVerbs = map[string]bool{
+	"am": true, "are": true, "be": true, "been": true, "being": true, "is": true, "was": true, "were": true,
+}
+
+// checkWords checks a passage for needless words, the passive voice and
+// doubled words.
+func (r *Rule) checkWords(runner tflint.Runner, p passage) {
+	words := splitWords(p.text)
+
+	if config := r.Config.NeedlessWords; config == nil || isEnabled(config.Enabled) {
+		list := defaultNeedlessWords
+		level := ""
+		if config != nil {
+			level = config.Level
+			if len(config.Words) > 0 {
+				list = config.Words
+			}
+		}
+		for _, phrase := range list {
+			for _, found := range findPhrase(words, phrase) {
+				rulehelper.EmitIssue(runner, r, "needless_words", level, fmt.Sprintf(NeedlessWordsMessage, found), p.rng)
+			}
+		}
+	}
+
+	if config := r.Config.Passive; config == nil || isEnabled(config.Enabled) {
+		participles := defaultParticiples
+		level := ""
+		if config != nil {
+			level = config.Level
+			if len(config.Participles) > 0 {
+				participles = config.Participles
+			}
+		}
+		for i := 1; i < len(words); i++ {
+			if words[i].adjacent && beVerbs[strings.ToLower(words[i-1].text)] && isParticiple(words[i].text, participles) {
+				phrase := words[i-1].text + " " + words[i].text
+				rulehelper.EmitIssue(runner, r, "passive", level, fmt.Sprintf(PassiveMessage, phrase), p.rng)
+			}
+		}
+	}
+
+	if r.Config.DoubledWords.Enabled() {
+		for i := 1; i < len(words); i++ {
+			if words[i].adjacent && strings.EqualFold(words[i].text, words[i-1].text) && isAlpha(words[i].text) {
+				rulehelper.EmitIssue(runner, r, "doubled_words", r.Config.DoubledWords.Level(), fmt.Sprintf(DoubledWordsMessage, words[i].text), p.rng)
+			}
+		}
+	}
+}
+
+// findPhrase returns each occurrence of phrase in words, as written. The words
+// of an occurrence may only be separated by spaces.
+func findPhrase(words []word, phrase string) []string {
+	want := splitWords(phrase)
+	if len(want) == 0 {
+		return nil
+	}
+
+	var found []string
+	for i := 0; i+len(want) <= len(words); i++ {
+		match := true
+		for j, w := range want {
+			if !strings.EqualFold(words[i+j].text, w.text) || (j > 0 && !words[i+j].adjacent) {
+				match = false
+				break
+			}
+		}
+		if match {
+			var texts []string
+			for _, w := range words[i : i+len(want)] {
+				texts = append(texts, w.text)
+			}
+			found = append(found, strings.Join(texts, " "))
+		}
+	}
+	return found
+}
+
+// isParticiple reports whether text is a past participle: a word ending in -ed
+// or one of the irregular participles.
+func isParticiple(text string, participles []string) bool {
+	text = strings.ToLower(text)
+	if len(text) > 3 && strings.HasSuffix(text, "ed") && isAlpha(text) {
+		return true
+	}
+	for _, participle := range participles {
+		if strings.EqualFold(text, participle) {
+			return true
+		}
+	}
+	return false
+}
+
+// isAlpha reports whether text consists of letters only.
+func isAlpha(text string) bool {
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			return false
+		}
+	}
+	return text != ""
+}
@@ -0,0 +1,48 @@
+# Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+# SPDX-License-Identifier: Apache-2.0
+
+rule "eos_prose" {
+  enabled = true
+}
+
+rule "eos_prose_strings" {
+  enabled = true
+  targets = ["description", "error_message"]
+}
+
+rule "eos_prose_words" {
+  enabled = true
+  needless_words {
+    words = ["utilize", "leverage"]
+    level = "error"
+  }
+  passive {
+    enabled = false
+  }
+  sentence_length {
+    max = 8
+  }
+}
+
+rule "eos_prose_levels" {
+  enabled       = true
+  doubled_words = "error"
+  punctuation   = "notice"
+}
+
+rule "eos_prose_bad_target" {
+  enabled = true
+  targets = ["heredoc"]
+}
+
+rule "eos_prose_bad_max" {
+  enabled = true
+  sentence_length {
+    max = 0
+  }
+}
+
+rule "eos_prose_typo" {
+  enabled = true
+  target  = ["comments"]
+}
@@ -0,0 +1,93 @@
+# Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+# SPDX-License-Identifier: Apache-2.0
+
+# #########
+# Tests that will emit issues.
+
+variable "needless" {
+  description = "The bucket to use in order to store the very large logs."
+  type        = string
+}
+
+variable "passive" {
+  description = "The key is rotated by the vault and was written to disk."
+  type        = string
+}
+
+variable "doubled" {
+  description = "The the region to deploy to."
+  type        = string
+}
+
+variable "lowercase" {
+  description = "the region to deploy to."
+  type        = string
+}
+
+variable "unpunctuated" {
+  description = "The region to deploy to"
+  type        = string
+
+  validation {
+    condition     = length(var.unpunctuated) > 0
+    error_message = "region must not be empty"
+  }
+}
+
+output "long" {
+  description = "The address of the load balancer that sits in front of the web tier and takes the traffic from the internet before it hands it on to one of the instances in the auto scaling group, which then serves the request."
+  value       = aws_lb.web.dns_name
+}
+
+# We basically leverage the bucket here.
+resource "aws_s3_bucket" "logs" {
+  bucket = "logs" # Keep the the name short.
+}
+
+# #########
+# Tests that will not emit issues.
+
+variable "good" {
+  description = "The region to deploy to, e.g. us-east-1."
+  type        = string
+
+  validation {
+    condition     = length(var.good) > 0
+    error_message = "Region ${var.good} is not known to ${var.good}"
+  }
+}
+
+variable "identifier" {
+  description = "subnet_ids of the VPC (see the README)."
+  type        = list(string)
+}
+
+variable "heredoc" {
+  description = <<-EOT
+    The tags to apply. Keys are tag names and values are
+    tag values.
+  EOT
+  type        = map(string)
+}
+
+# A text of only quotes has no sentence.
+variable "quotes" {
+  description = "\"\""
+  type        = string
+
+  validation {
+    condition     = var.quotes != ""
+    error_message = ")"
+  }
+}
+
+# Copy the logs to cold storage after thirty days
+resource "aws_s3_bucket" "archive" {
+  bucket = "archive"
+}
+
+# eos-ignore: prose.passive -- the vault does the rotating
+output "rotated" {
+  description = "The key is rotated daily."
+  value       = var.passive
+}
@@ -9,7 +9,6 @@ import (
 	"os"
 	"testing"
 
-	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
 	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/testhelper"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 )
@@ -31,11 +30,7 @@ func testReminderConfig(t *testing.T) {
 		},
 		{
 			Name: "eos_reminder_disabled",
-			Want: func() reminderConfig {
-				cfg := defaultReminderConfig
-				cfg.Enabled = rulehelper.BoolPtr(false)
-				return cfg
-			}(),
+			Want: defaultReminderConfig,
 		},
 		{
 			Name: "eos_reminder_extras",
@@ -1,16 +1,20 @@
 # Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
 # SPDX-License-Identifier: Apache-2.0
 
-rule "eos_reminder" {}
+rule "eos_reminder" {
+  enabled = true
+}
 
 rule "eos_reminder_disabled" {
   enabled = false
 }
 
 rule "eos_reminder_extras" {
+  enabled = true
   extras = ["NOTGOOD", "REALBAD"]
 }
 
 rule "eos_reminder_many_tags" {
+  enabled = true
   tags = ["BUG", "FIXME", "HORROR", "TODO"]
 }
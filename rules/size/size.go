@@ -0,0 +1,264 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package size
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// Messages emitted by the checks.
+const (
+	BlockMessage     = "%s is %d lines long (maximum %d)."
+	FileMessage      = "File is %d lines long (maximum %d)."
+	LocalsMessage    = "Locals block has %d entries (maximum %d)."
+	ResourcesMessage = "Module has %d resources (maximum %d)."
+)
+
+// defaultBlockLines maps each block type to the most lines a block of it may
+// take. Types not listed may be any length.
+var defaultBlockLines = map[string]int{
+	"module":   100,
+	"resource": 100,
+}
+
+// sizeConfig represents the configuration for the SizeRule. A limit of 0
+// turns its check off.
+type sizeConfig struct {
+	// BlockLines maps block types to the most lines a block of the type may
+	// take. Its entries replace those of the defaults.
+	BlockLines map[string]int `hclext:"block_lines,optional" hcl:"block_lines,optional"`
+	Enabled    *bool          `hclext:"enabled,optional" hcl:"enabled,optional"`
+	// FileLines is the most lines a file may have.
+	FileLines int    `hclext:"file_lines,optional" hcl:"file_lines,optional"`
+	Level     string `hclext:"level,optional" hcl:"level,optional"`
+	// LocalsEntries is the most entries a locals block may have.
+	LocalsEntries int `hclext:"locals_entries,optional" hcl:"locals_entries,optional"`
+	// ModuleResources is the most resource blocks a module may have, over all
+	// of its files.
+	ModuleResources int `hclext:"module_resources,optional" hcl:"module_resources,optional"`
+}
+
+// Validate checks the values of the config.
+func (c *sizeConfig) Validate() error {
+	var types []string
+	for _, block := range rulehelper.TerraformSchema.Blocks {
+		types = append(types, block.Type)
+	}
+	for typ, limit := range c.BlockLines {
+		if !slices.Contains(types, typ) {
+			return fmt.Errorf("\"block_lines\" keys must be block types, one of %s, not %q", strings.Join(types, ", "), typ)
+		}
+		if limit < 0 {
+			return fmt.Errorf("\"block_lines\" must be at least 0, not %d for %q", limit, typ)
+		}
+	}
+	for _, limit := range []struct {
+		name  string
+		value int
+	}{{"file_lines", c.FileLines}, {"locals_entries", c.LocalsEntries}, {"module_resources", c.ModuleResources}} {
+		if limit.value < 0 {
+			return fmt.Errorf("%q must be at least 0, not %d", limit.name, limit.value)
+		}
+	}
+	return nil
+}
+
+// defaultSizeConfig is the default configuration for the SizeRule. The block
+// limits default to defaultBlockLines.
+var defaultSizeConfig = sizeConfig{
+	Enabled:         rulehelper.BoolPtr(true),
+	FileLines:       500,
+	Level:           "warning",
+	LocalsEntries:   40,
+	ModuleResources: 50,
+}
+
+// Rule checks the size of files, blocks and modules.
+type Rule struct {
+	tflint.DefaultRule
+	Config sizeConfig
+	// RuleName is the rule block name to load from the config file. If empty,
+	// defaults to "eos_size".
+	RuleName string
+	// overrides are the override blocks of the rule block, applied by ForFile.
+	overrides rulehelper.Overrides[sizeConfig]
+}
+
+// Check checks whether the rule conditions are met.
+func (r *Rule) Check(runner tflint.Runner) error {
+	ignores, overrides, err := rulehelper.BeginCheck(runner, r, &r.Config)
+	if err != nil || ignores == nil {
+		return err
+	}
+	r.overrides = overrides
+
+	files, err := ignores.GetFiles()
+	if err != nil {
+		return err
+	}
+	filenames := make([]string, 0, len(files))
+	for filename := range files {
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+
+	// The resources of each module, i.e. directory, in file order. Local
+	// modules are linted along with the root, so there may be several.
+	resources := map[string][]*hcl.Block{}
+	for _, filename := range filenames {
+		source := rulehelper.Source(filename, files[filename])
+		dir := filepath.Dir(filename)
+		for _, block := range source.Blocks() {
+			if block.Type == "resource" {
+				resources[dir] = append(resources[dir], block)
+			}
+		}
+
+		rule, enabled := rulehelper.ForFile(r, filename)
+		if !enabled {
+			continue
+		}
+		rule.checkFile(ignores, filename, source)
+	}
+
+	r.checkResources(ignores, resources)
+	return ignores.ReportIgnores()
+}
+
+// checkFile checks the length of a file, and the size of its blocks.
+func (r *Rule) checkFile(runner tflint.Runner, filename string, source *rulehelper.SourceFile) {
+	src := source.File().Bytes
+	lines := bytes.Count(src, []byte("\n"))
+	if len(src) > 0 && src[len(src)-1] != '\n' {
+		lines++
+	}
+	if r.Config.FileLines > 0 && lines > r.Config.FileLines {
+		rng := hcl.Range{
+			Filename: filename,
+			Start:    hcl.Pos{Line: 1, Column: 1},
+			End:      hcl.Pos{Line: 1, Column: 1},
+		}
+		rulehelper.EmitIssue(runner, r, "file", "", fmt.Sprintf(FileMessage, lines, r.Config.FileLines), rng)
+	}
+
+	for _, block := range source.Blocks() {
+		if block.Type == "locals" {
+			r.checkLocals(runner, block)
+		}
+		r.checkBlock(runner, block)
+	}
+}
+
+// checkBlock checks the number of lines a block takes, from its type to its
+// closing brace. The blocks of JSON files aren't checked, as their length
+// says little about the configuration they hold.
+func (r *Rule) checkBlock(runner tflint.Runner, block *hcl.Block) {
+	limit := r.blockLines(block.Type)
+	body, ok := block.Body.(*hclsyntax.Body)
+	if limit <= 0 || !ok {
+		return
+	}
+
+	lines := body.SrcRange.End.Line - block.DefRange.Start.Line + 1
+	if lines > limit {
+		rulehelper.EmitIssue(runner, r, "block", "", fmt.Sprintf(BlockMessage, rulehelper.DescribeBlock(block), lines, limit), rulehelper.DefRange(block))
+	}
+}
+
+// checkLocals checks the number of entries of a locals block.
+func (r *Rule) checkLocals(runner tflint.Runner, block *hcl.Block) {
+	if r.Config.LocalsEntries <= 0 {
+		return
+	}
+
+	attrs, _ := block.Body.JustAttributes()
+	if len(attrs) > r.Config.LocalsEntries {
+		rulehelper.EmitIssue(runner, r, "locals", "", fmt.Sprintf(LocalsMessage, len(attrs), r.Config.LocalsEntries), rulehelper.DefRange(block))
+	}
+}
+
+// checkResources checks the number of resources of each module. The issue is
+// reported at the first resource past the limit, in file order.
+func (r *Rule) checkResources(runner tflint.Runner, resources map[string][]*hcl.Block) {
+	if r.Config.ModuleResources <= 0 {
+		return
+	}
+
+	dirs := make([]string, 0, len(resources))
+	for dir := range resources {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+
+	for _, dir := range dirs {
+		blocks := resources[dir]
+		if len(blocks) <= r.Config.ModuleResources {
+			continue
+		}
+		message := fmt.Sprintf(ResourcesMessage, len(blocks), r.Config.ModuleResources)
+		rulehelper.EmitIssue(runner, r, "resources", "", message, rulehelper.DefRange(blocks[r.Config.ModuleResources]))
+	}
+}
+
+// blockLines returns the most lines a block of typ may take, or 0 if it may
+// be any length.
+func (r *Rule) blockLines(typ string) int {
+	if limit, ok := r.Config.BlockLines[typ]; ok {
+		return limit
+	}
+	return defaultBlockLines[typ]
+}
+
+// NewSizeRule returns a new rule.
+func NewSizeRule() *Rule {
+	rule := &Rule{}
+	rule.Config = defaultSizeConfig
+	return rule
+}
+
+// ForFile returns the rule with the config that applies to filename.
+func (r *Rule) ForFile(filename string) *Rule {
+	config, ok := r.overrides.Match(filename)
+	if !ok {
+		return r
+	}
+	fileRule := *r
+	fileRule.Config = config
+	return &fileRule
+}
+
+// Enabled returns whether the rule is enabled by default.
+func (r *Rule) Enabled() bool {
+	return r.Config.Enabled == nil || *r.Config.Enabled
+}
+
+// Link returns the rule reference link.
+func (r *Rule) Link() string {
+	return "https://github.com/tfctl/tflint-ruleset-elements-of-style/blob/main/docs/rules/eos_size.md"
+}
+
+// Name returns the rule name.
+func (r *Rule) Name() string {
+	if r.RuleName != "" {
+		return r.RuleName
+	}
+
+	return "eos_size"
+}
+
+// Severity returns the rule severity.
+func (r *Rule) Severity() tflint.Severity {
+	return rulehelper.ToSeverity(r.Config.Level)
+}
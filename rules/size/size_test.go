@@ -0,0 +1,165 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package size
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/testhelper"
+)
+
+func TestSize(t *testing.T) {
+	if !flag.Parsed() {
+		flag.Parse()
+	}
+
+	t.Run("Config", testSizeConfig)
+	t.Run("ConfigErrors", testSizeConfigErrors)
+
+	t.Run("Files", testSizeFiles)
+	t.Run("Levels", testSizeLevels)
+	t.Run("Rule", testSizeRule)
+}
+
+func testSizeConfig(t *testing.T) {
+	cases := []testhelper.ConfigTestCase{
+		{
+			Name: "eos_size",
+			Want: defaultSizeConfig,
+		},
+		{
+			Name: "eos_size_small",
+			Want: func() sizeConfig {
+				cfg := defaultSizeConfig
+				cfg.BlockLines = map[string]int{"module": 0, "resource": 6, "variable": 4}
+				cfg.FileLines = 40
+				cfg.Level = "notice"
+				cfg.LocalsEntries = 3
+				cfg.ModuleResources = 2
+				return cfg
+			}(),
+		},
+	}
+
+	testhelper.ConfigTestRunner(t, defaultSizeConfig, cases)
+}
+
+func testSizeConfigErrors(t *testing.T) {
+	cases := []testhelper.ConfigErrorTestCase{
+		{
+			Name: "eos_size_bad_type",
+			Want: `rule "eos_size_bad_type": "block_lines" keys must be block types, one of terraform, provider, variable, locals, output, module, resource, data, ephemeral, check, moved, import, removed, not "resources"`,
+		},
+		{
+			Name: "eos_size_negative",
+			Want: `rule "eos_size_negative": "file_lines" must be at least 0, not -1`,
+		},
+		{
+			Name: "eos_size_typo",
+			Want: `rule "eos_size_typo": unknown option "max_lines" at testdata/.tflint_test.hcl:35,3-12. Valid options: block_lines, enabled, file_lines, level, locals_entries, module_resources, override { files, ... }.`,
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewSizeRule() }
+	testhelper.ConfigErrorTestRunner(t, ruleFactory, cases)
+}
+
+func testSizeRule(t *testing.T) {
+	content, _ := os.ReadFile("./testdata/size_test.tf")
+
+	cases := []testhelper.RuleTestCase{
+		{
+			Name:    "eos_size",
+			Content: string(content),
+			Want:    []string{},
+		},
+		{
+			Name:    "eos_size_small",
+			Content: string(content),
+			Want: []string{
+				fmt.Sprintf(FileMessage, 58, 40),
+				fmt.Sprintf(LocalsMessage, 4, 3),
+				fmt.Sprintf(BlockMessage, "Variable 'retention'", 5, 4),
+				fmt.Sprintf(BlockMessage, "Resource 'aws_s3_bucket.logs'", 8, 6),
+				fmt.Sprintf(BlockMessage, "Resource 'aws_s3_bucket_versioning.logs'", 7, 6),
+				// The annotation names size.block, not this rule.
+				fmt.Sprintf(BlockMessage, "Resource 'aws_s3_bucket_lifecycle_configuration.logs'", 12, 6),
+				fmt.Sprintf(ResourcesMessage, 3, 2),
+			},
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewSizeRule() }
+	testhelper.RuleTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "size_test.tf")
+}
+
+func testSizeFiles(t *testing.T) {
+	cases := []testhelper.FilesTestCase{
+		{
+			// Resources are counted for each module, over all of its files,
+			// and the locals of JSON files are checked too.
+			Name: "eos_size_small",
+			Files: map[string]string{
+				"main.tf": `resource "aws_s3_bucket" "logs" {
+  bucket = "logs"
+}
+`,
+				"modules/archive/main.tf": `resource "aws_s3_bucket" "archive" {
+  bucket = "archive"
+}
+
+resource "aws_s3_bucket_versioning" "archive" {
+  bucket = aws_s3_bucket.archive.id
+}
+`,
+				"modules/archive/policy.tf": `resource "aws_s3_bucket_policy" "archive" {
+  bucket = aws_s3_bucket.archive.id
+  policy = "{}"
+}
+`,
+				"locals.tf.json": `{
+  "locals": {
+    "name": "logs",
+    "environment": "production",
+    "region": "us-east-1",
+    "owner": "platform"
+  }
+}
+`,
+			},
+			Want: []string{
+				fmt.Sprintf(LocalsMessage, 4, 3),
+				fmt.Sprintf(ResourcesMessage, 3, 2),
+			},
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewSizeRule() }
+	testhelper.FilesTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases)
+}
+
+func testSizeLevels(t *testing.T) {
+	cases := []testhelper.SeverityTestCase{
+		{
+			Name: "eos_size_small",
+			Content: `locals {
+  name        = "logs"
+  environment = "production"
+  region      = "us-east-1"
+  owner       = "platform"
+}
+`,
+			Want: map[string]tflint.Severity{
+				fmt.Sprintf(LocalsMessage, 4, 3): tflint.NOTICE,
+			},
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewSizeRule() }
+	testhelper.SeverityTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "size_levels.tf")
+}
@@ -0,0 +1,36 @@
+# Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+# SPDX-License-Identifier: Apache-2.0
+
+rule "eos_size" {
+  enabled = true
+}
+
+rule "eos_size_small" {
+  enabled          = true
+  level            = "notice"
+  file_lines       = 40
+  locals_entries   = 3
+  module_resources = 2
+  block_lines = {
+    module   = 0
+    resource = 6
+    variable = 4
+  }
+}
+
+rule "eos_size_bad_type" {
+  enabled = true
+  block_lines = {
+    resources = 10
+  }
+}
+
+rule "eos_size_negative" {
+  enabled    = true
+  file_lines = -1
+}
+
+rule "eos_size_typo" {
+  enabled   = true
+  max_lines = 10
+}
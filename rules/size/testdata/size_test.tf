@@ -0,0 +1,58 @@
+# Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+# SPDX-License-Identifier: Apache-2.0
+
+# The file is longer than the small limit, and holds a resource more than it
+# allows.
+
+locals {
+  name        = "logs"
+  environment = "production"
+  region      = "us-east-1"
+  owner       = "platform"
+}
+
+variable "retention" {
+  description = "The number of days to keep the logs for."
+  type        = number
+  default     = 30
+}
+
+resource "aws_s3_bucket" "logs" {
+  bucket = local.name
+
+  tags = {
+    Environment = local.environment
+    Owner       = local.owner
+  }
+}
+
+resource "aws_s3_bucket_versioning" "logs" {
+  bucket = aws_s3_bucket.logs.id
+
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+# eos-ignore: size.block -- the rules are kept together
+resource "aws_s3_bucket_lifecycle_configuration" "logs" {
+  bucket = aws_s3_bucket.logs.id
+
+  rule {
+    id     = "expire"
+    status = "Enabled"
+
+    expiration {
+      days = var.retention
+    }
+  }
+}
+
+module "archive" {
+  source = "./modules/archive"
+
+  bucket    = aws_s3_bucket.logs.id
+  retention = var.retention
+  name      = local.name
+  owner     = local.owner
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package spacing
+
+import (
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// spacingConfig represents the configuration for the SpacingRule.
+type spacingConfig struct {
+	BetweenBlocks rulehelper.SubCheck `hclext:"between_blocks,optional" hcl:"between_blocks,optional"`
+	BlankLines    rulehelper.SubCheck `hclext:"blank_lines,optional" hcl:"blank_lines,optional"`
+	Braces        rulehelper.SubCheck `hclext:"braces,optional" hcl:"braces,optional"`
+	Enabled       *bool               `hclext:"enabled,optional" hcl:"enabled,optional"`
+	Level         string              `hclext:"level,optional" hcl:"level,optional"`
+	// MetaFirst are the meta-arguments meta_group expects to lead a block.
+	MetaFirst []string            `hclext:"meta_first,optional" hcl:"meta_first,optional"`
+	MetaGroup rulehelper.SubCheck `hclext:"meta_group,optional" hcl:"meta_group,optional"`
+	// MetaLast are the meta-arguments meta_group expects to close a block.
+	MetaLast []string `hclext:"meta_last,optional" hcl:"meta_last,optional"`
+}
+
+// defaultSpacingConfig is the default configuration for the SpacingRule. The
+// meta-arguments default to those of eos_meta's default order.
+var defaultSpacingConfig = spacingConfig{
+	Enabled:   rulehelper.BoolPtr(true),
+	Level:     "warning",
+	MetaFirst: rulehelper.FirstMetaArguments,
+	MetaLast:  rulehelper.LastMetaArguments,
+}
+
+// Rule checks the vertical whitespace of a file: the blank lines that
+// terraform fmt leaves alone.
+type Rule struct {
+	tflint.DefaultRule
+	Config spacingConfig
+	// RuleName is the rule block name to load from the config file. If empty,
+	// defaults to "eos_spacing".
+	RuleName string
+	// overrides are the override blocks of the rule block, applied by ForFile.
+	overrides rulehelper.Overrides[spacingConfig]
+}
+
+// Check checks whether the rule conditions are met.
+func (r *Rule) Check(runner tflint.Runner) error {
+	ignores, overrides, err := rulehelper.BeginCheck(runner, r, &r.Config)
+	if err != nil || ignores == nil {
+		return err
+	}
+	r.overrides = overrides
+
+	if err := rulehelper.WalkFileTokens(ignores, r, checkSpacing); err != nil {
+		return err
+	}
+	return ignores.ReportIgnores()
+}
+
+// checkSpacing runs the enabled checks on a file.
+func checkSpacing(runner tflint.Runner, r *Rule, source *rulehelper.SourceFile, tokens hclsyntax.Tokens) {
+	lines := newLineMap(source.File().Bytes, tokens)
+
+	// A run of blank lines after an opening brace or before a closing one is
+	// removed whole by the braces fix, so the blank lines check leaves it be.
+	var braceRuns map[int]bool
+	if r.Config.Braces.Enabled() {
+		braceRuns = r.checkBraces(runner, lines, tokens)
+	}
+	if r.Config.BlankLines.Enabled() {
+		r.checkBlankLines(runner, lines, braceRuns)
+	}
+	if r.Config.BetweenBlocks.Enabled() {
+		r.checkBetweenBlocks(runner, lines, tokens)
+	}
+	if body, ok := source.File().Body.(*hclsyntax.Body); ok && r.Config.MetaGroup.Enabled() {
+		r.checkMetaGroup(runner, lines, source.CommentMap(), body)
+	}
+}
+
+// NewSpacingRule returns a new rule.
+func NewSpacingRule() *Rule {
+	rule := &Rule{}
+	rule.Config = defaultSpacingConfig
+	return rule
+}
+
+// ForFile returns the rule with the config that applies to filename.
+func (r *Rule) ForFile(filename string) *Rule {
+	config, ok := r.overrides.Match(filename)
+	if !ok {
+		return r
+	}
+	fileRule := *r
+	fileRule.Config = config
+	return &fileRule
+}
+
+// Enabled returns whether the rule is enabled by default.
+func (r *Rule) Enabled() bool {
+	return r.Config.Enabled == nil || *r.Config.Enabled
+}
+
+// Link returns the rule reference link.
+func (r *Rule) Link() string {
+	return "https://github.com/tfctl/tflint-ruleset-elements-of-style/blob/main/docs/rules/eos_spacing.md"
+}
+
+// Name returns the rule name.
+func (r *Rule) Name() string {
+	if r.RuleName != "" {
+		return r.RuleName
+	}
+
+	return "eos_spacing"
+}
+
+// Severity returns the rule severity.
+func (r *Rule) Severity() tflint.Severity {
+	return rulehelper.ToSeverity(r.Config.Level)
+}
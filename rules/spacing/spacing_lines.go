@@ -0,0 +1,210 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package spacing
+
+import (
+	"bytes"
+
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// Messages emitted by the line checks.
+const (
+	BlankLinesMessage       = "Avoid consecutive blank lines."
+	BlankAfterBraceMessage  = "Remove the blank line after '{'."
+	BlankBeforeBraceMessage = "Remove the blank line before '}'."
+	BetweenBlocksMessage    = "Separate top-level blocks with a blank line."
+)
+
+// lineMap tells the blank lines of a file from the others, by its tokens
+// rather than its text, so the empty lines of heredocs, multi-line strings
+// and block comments aren't taken for blank lines.
+type lineMap struct {
+	filename string
+	// blank says whether each line, numbered from 1, holds no token.
+	blank []bool
+	// starts holds the byte offset of the start of each line, numbered from
+	// 1, followed by the length of the file.
+	starts []int
+}
+
+// newLineMap returns the line map of a file.
+func newLineMap(src []byte, tokens hclsyntax.Tokens) *lineMap {
+	m := &lineMap{starts: []int{0, 0}}
+	for i, b := range src {
+		if b == '\n' && i+1 < len(src) {
+			m.starts = append(m.starts, i+1)
+		}
+	}
+	m.starts = append(m.starts, len(src))
+
+	count := len(m.starts) - 2
+	m.blank = make([]bool, count+1)
+	for line := 1; line <= count; line++ {
+		m.blank[line] = true
+	}
+
+	for _, token := range tokens {
+		m.filename = token.Range.Filename
+		if token.Type == hclsyntax.TokenNewline || token.Type == hclsyntax.TokenEOF {
+			continue
+		}
+		last := token.Range.End.Line
+		// Line comments and heredoc lines take the newline with them.
+		if token.Range.End.Column == 1 && last > token.Range.Start.Line {
+			last--
+		}
+		for line := token.Range.Start.Line; line <= last && line <= count; line++ {
+			m.blank[line] = false
+		}
+	}
+	return m
+}
+
+// isBlank reports whether line is a blank line of the file.
+func (m *lineMap) isBlank(line int) bool {
+	return line >= 1 && line < len(m.blank) && m.blank[line]
+}
+
+// lines returns the range of the lines first through last, including the
+// newline of the last.
+func (m *lineMap) lines(first int, last int) hcl.Range {
+	return hcl.Range{
+		Filename: m.filename,
+		Start:    hcl.Pos{Line: first, Column: 1, Byte: m.starts[first]},
+		End:      hcl.Pos{Line: last + 1, Column: 1, Byte: m.starts[last+1]},
+	}
+}
+
+// removeLines returns a fix removing the lines of rng.
+func removeLines(rng hcl.Range) func(tflint.Fixer) error {
+	return func(f tflint.Fixer) error {
+		return f.Remove(rng)
+	}
+}
+
+// insertBlankLine returns a fix inserting a blank line before line.
+func (m *lineMap) insertBlankLine(line int) func(tflint.Fixer) error {
+	pos := hcl.Pos{Line: line, Column: 1, Byte: m.starts[line]}
+	return func(f tflint.Fixer) error {
+		return f.InsertTextBefore(hcl.Range{Filename: m.filename, Start: pos, End: pos}, "\n")
+	}
+}
+
+// endsLine reports whether token is a newline or a line comment, which takes
+// its newline with it.
+func endsLine(token hclsyntax.Token) bool {
+	return token.Type == hclsyntax.TokenNewline ||
+		(token.Type == hclsyntax.TokenComment && bytes.HasSuffix(token.Bytes, []byte("\n")))
+}
+
+// checkBraces reports the blank lines right after an opening brace or right
+// before a closing one. It returns the first lines of the runs of blank lines
+// it reported.
+func (r *Rule) checkBraces(runner tflint.Runner, lines *lineMap, tokens hclsyntax.Tokens) map[int]bool {
+	runs := map[int]bool{}
+	for i, token := range tokens {
+		switch token.Type {
+		case hclsyntax.TokenOBrace:
+			// The brace must end its line, bar a comment.
+			if i+1 >= len(tokens) || !(endsLine(tokens[i+1]) || tokens[i+1].Type == hclsyntax.TokenComment && i+2 < len(tokens) && endsLine(tokens[i+2])) {
+				continue
+			}
+			first := token.Range.Start.Line + 1
+			if !lines.isBlank(first) {
+				continue
+			}
+			last := first
+			for lines.isBlank(last + 1) {
+				last++
+			}
+			runs[first] = true
+			rng := lines.lines(first, last)
+			rulehelper.EmitIssueWithFix(runner, r, "braces", r.Config.Braces.Level(), BlankAfterBraceMessage, rng, removeLines(rng))
+
+		case hclsyntax.TokenCBrace:
+			// The brace must start its line.
+			if i == 0 || !endsLine(tokens[i-1]) {
+				continue
+			}
+			last := token.Range.Start.Line - 1
+			if !lines.isBlank(last) {
+				continue
+			}
+			first := last
+			for lines.isBlank(first - 1) {
+				first--
+			}
+			// An empty block with a blank line in it was reported at its
+			// opening brace.
+			if runs[first] {
+				continue
+			}
+			runs[first] = true
+			rng := lines.lines(first, last)
+			rulehelper.EmitIssueWithFix(runner, r, "braces", r.Config.Braces.Level(), BlankBeforeBraceMessage, rng, removeLines(rng))
+		}
+	}
+	return runs
+}
+
+// checkBlankLines reports the runs of more than one blank line, other than
+// those in skip, keyed by their first line.
+func (r *Rule) checkBlankLines(runner tflint.Runner, lines *lineMap, skip map[int]bool) {
+	for first := 1; first < len(lines.blank); first++ {
+		if !lines.isBlank(first) {
+			continue
+		}
+		last := first
+		for lines.isBlank(last + 1) {
+			last++
+		}
+		if last > first && !skip[first] {
+			// One blank line stays.
+			rng := lines.lines(first+1, last)
+			rulehelper.EmitIssueWithFix(runner, r, "blank_lines", r.Config.BlankLines.Level(), BlankLinesMessage, rng, removeLines(rng))
+		}
+		first = last
+	}
+}
+
+// checkBetweenBlocks reports the top-level blocks, or the comments leading
+// them, that follow the closing brace of another block on the next line.
+func (r *Rule) checkBetweenBlocks(runner tflint.Runner, lines *lineMap, tokens hclsyntax.Tokens) {
+	depth := 0
+	for i, token := range tokens {
+		switch token.Type {
+		case hclsyntax.TokenOBrace:
+			depth++
+			continue
+		case hclsyntax.TokenCBrace:
+			depth--
+		default:
+			continue
+		}
+		if depth != 0 {
+			continue
+		}
+
+		// Skip the rest of the line of the closing brace, e.g. a comment.
+		end := token.Range.Start.Line
+		j := i + 1
+		for j < len(tokens) && (tokens[j].Type == hclsyntax.TokenNewline || tokens[j].Range.Start.Line == end) {
+			j++
+		}
+		if j >= len(tokens) || tokens[j].Type == hclsyntax.TokenEOF {
+			continue
+		}
+
+		next := tokens[j].Range.Start.Line
+		if next == end+1 {
+			rng := tokens[j].Range
+			rulehelper.EmitIssueWithFix(runner, r, "between_blocks", r.Config.BetweenBlocks.Level(), BetweenBlocksMessage, rng, lines.insertBlankLine(next))
+		}
+	}
+}
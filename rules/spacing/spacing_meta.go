@@ -0,0 +1,89 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package spacing
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// MetaGroupMessage is emitted for meta-arguments not set apart from the other
+// arguments of a block by a blank line.
+const MetaGroupMessage = "Separate the meta-arguments from the other arguments with a blank line."
+
+// metaBlocks are the block types that take meta-arguments.
+var metaBlocks = map[string]bool{"data": true, "ephemeral": true, "module": true, "resource": true}
+
+// metaItem is an argument or nested block of a block body.
+type metaItem struct {
+	node hclsyntax.Node
+	name string
+}
+
+// checkMetaGroup reports the first argument after the leading meta-arguments
+// of a block, and the first of its trailing meta-arguments, if no blank line
+// sets them apart from the other arguments. The meta-arguments are those of
+// MetaFirst and MetaLast.
+func (r *Rule) checkMetaGroup(runner tflint.Runner, lines *lineMap, comments *rulehelper.CommentMap, body *hclsyntax.Body) {
+	for _, block := range body.Blocks {
+		if !metaBlocks[block.Type] {
+			continue
+		}
+
+		var items []metaItem
+		for name, attr := range block.Body.Attributes {
+			items = append(items, metaItem{node: attr, name: name})
+		}
+		for _, nested := range block.Body.Blocks {
+			items = append(items, metaItem{node: nested, name: nested.Type})
+		}
+		sort.Slice(items, func(i, j int) bool {
+			return items[i].node.Range().Start.Byte < items[j].node.Range().Start.Byte
+		})
+
+		lead := 0
+		for lead < len(items) && slices.Contains(r.Config.MetaFirst, items[lead].name) {
+			lead++
+		}
+		trail := len(items)
+		for trail > lead && slices.Contains(r.Config.MetaLast, items[trail-1].name) {
+			trail--
+		}
+
+		for i, split := range []int{lead, trail} {
+			// Meta-arguments both lead and close a block with nothing else
+			// in it, e.g. count and depends_on, when the splits coincide.
+			if split == 0 || split == len(items) || (i == 1 && split == lead) {
+				continue
+			}
+			prevEnd := items[split-1].node.Range().End.Line
+			next := comments.Extent(items[split].node).Start.Line
+			if !lines.hasBlankBetween(prevEnd, next) {
+				rng := items[split].node.Range()
+				if attr, ok := items[split].node.(*hclsyntax.Attribute); ok {
+					rng = attr.NameRange
+				} else if nested, ok := items[split].node.(*hclsyntax.Block); ok {
+					rng = nested.TypeRange
+				}
+				rulehelper.EmitIssueWithFix(runner, r, "meta_group", r.Config.MetaGroup.Level(), MetaGroupMessage, rng, lines.insertBlankLine(next))
+			}
+		}
+	}
+}
+
+// hasBlankBetween reports whether a blank line lies between the lines after
+// and before.
+func (m *lineMap) hasBlankBetween(after int, before int) bool {
+	for line := after + 1; line < before; line++ {
+		if m.isBlank(line) {
+			return true
+		}
+	}
+	return false
+}
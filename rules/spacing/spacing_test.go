@@ -0,0 +1,168 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package spacing
+
+import (
+	"flag"
+	"os"
+	"testing"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/testhelper"
+)
+
+func TestSpacing(t *testing.T) {
+	if !flag.Parsed() {
+		flag.Parse()
+	}
+
+	t.Run("Config", testSpacingConfig)
+	t.Run("ConfigErrors", testSpacingConfigErrors)
+
+	t.Run("Fix", testSpacingFix)
+	t.Run("Levels", testSpacingLevels)
+	t.Run("Rule", testSpacingRule)
+}
+
+func testSpacingConfig(t *testing.T) {
+	cases := []testhelper.ConfigTestCase{
+		{
+			Name: "eos_spacing",
+			Want: defaultSpacingConfig,
+		},
+		{
+			Name: "eos_spacing_relaxed",
+			Want: func() spacingConfig {
+				cfg := defaultSpacingConfig
+				cfg.BlankLines = "notice"
+				cfg.Braces = "false"
+				cfg.MetaGroup = "error"
+				return cfg
+			}(),
+		},
+		{
+			Name: "eos_spacing_meta",
+			Want: func() spacingConfig {
+				cfg := defaultSpacingConfig
+				cfg.MetaFirst = []string{"provider", "count", "for_each"}
+				cfg.MetaLast = []string{"depends_on"}
+				return cfg
+			}(),
+		},
+	}
+
+	testhelper.ConfigTestRunner(t, defaultSpacingConfig, cases)
+}
+
+func testSpacingConfigErrors(t *testing.T) {
+	cases := []testhelper.ConfigErrorTestCase{
+		{
+			Name: "eos_spacing_typo",
+			Want: `rule "eos_spacing_typo": unknown option "brace" at testdata/.tflint_test.hcl:17,3-8. Did you mean "braces"? Valid options: between_blocks, blank_lines, braces, enabled, level, meta_first, meta_group, meta_last, override { files, ... }.`,
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewSpacingRule() }
+	testhelper.ConfigErrorTestRunner(t, ruleFactory, cases)
+}
+
+func testSpacingRule(t *testing.T) {
+	content, _ := os.ReadFile("./testdata/spacing_test.tf")
+
+	cases := []testhelper.RuleTestCase{
+		{
+			Name:    "eos_spacing",
+			Content: string(content),
+			Want: []string{
+				BlankAfterBraceMessage,
+				BlankBeforeBraceMessage,
+				BlankLinesMessage,
+				BetweenBlocksMessage,
+				MetaGroupMessage,
+				MetaGroupMessage,
+			},
+		},
+		{
+			Name:    "eos_spacing_relaxed",
+			Content: string(content),
+			Want: []string{
+				BlankLinesMessage,
+				BetweenBlocksMessage,
+				// The annotation names spacing.between_blocks, not this rule.
+				BetweenBlocksMessage,
+				MetaGroupMessage,
+				MetaGroupMessage,
+			},
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewSpacingRule() }
+	testhelper.RuleTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "spacing_test.tf")
+
+	// The meta-arguments can be changed to match eos_meta's order. By
+	// default, lifecycle closes the block along with depends_on.
+	cases = []testhelper.RuleTestCase{
+		{
+			Name: "eos_spacing_meta",
+			Content: `resource "aws_instance" "web" {
+  provider = aws.east
+  count    = 2
+
+  ami           = "ami-123456"
+  instance_type = "t3.micro"
+
+  lifecycle {
+    create_before_destroy = true
+  }
+  depends_on = [aws_iam_role.web]
+}
+`,
+			Want: []string{
+				MetaGroupMessage,
+			},
+		},
+	}
+	testhelper.RuleTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "spacing_meta.tf")
+}
+
+func testSpacingLevels(t *testing.T) {
+	cases := []testhelper.SeverityTestCase{
+		{
+			Name: "eos_spacing_relaxed",
+			Content: `resource "aws_instance" "web" {
+  count = 2
+  ami   = "ami-123456"
+}
+
+
+resource "aws_eip" "web" {
+  instance = aws_instance.web[0].id
+}
+`,
+			Want: map[string]tflint.Severity{
+				BlankLinesMessage: tflint.NOTICE,
+				MetaGroupMessage:  tflint.ERROR,
+			},
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewSpacingRule() }
+	testhelper.SeverityTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "spacing_levels.tf")
+}
+
+func testSpacingFix(t *testing.T) {
+	content, _ := os.ReadFile("./testdata/spacing_fix.tf")
+	fixed, _ := os.ReadFile("./testdata/spacing_fix_fixed.tf")
+
+	cases := []testhelper.FixTestCase{
+		{
+			Name:    "eos_spacing",
+			Content: string(content),
+			Want:    string(fixed),
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewSpacingRule() }
+	testhelper.FixTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "spacing_fix.tf")
+}
@@ -0,0 +1,24 @@
+# Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+# SPDX-License-Identifier: Apache-2.0
+
+rule "eos_spacing" {
+  enabled = true
+}
+
+rule "eos_spacing_relaxed" {
+  enabled     = true
+  blank_lines = "notice"
+  braces      = false
+  meta_group  = "error"
+}
+
+rule "eos_spacing_typo" {
+  enabled = true
+  brace   = false
+}
+
+rule "eos_spacing_meta" {
+  enabled    = true
+  meta_first = ["provider", "count", "for_each"]
+  meta_last  = ["depends_on"]
+}
@@ -0,0 +1,28 @@
+terraform {
+
+  required_version = ">= 1.5"
+
+
+}
+variable "region" {
+  type = string
+}
+
+
+
+# The bucket of the logs.
+resource "aws_s3_bucket" "logs" {
+  count  = 1
+  bucket = "logs"
+  # Wait for the role.
+  depends_on = [aws_iam_role.logs]
+}
+
+resource "aws_s3_bucket_policy" "logs" {
+  for_each = var.policies
+  bucket   = aws_s3_bucket.logs[0].id
+
+  tags = {
+
+  }
+}
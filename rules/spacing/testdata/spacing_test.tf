@@ -0,0 +1,44 @@
+# Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+# SPDX-License-Identifier: Apache-2.0
+
+terraform {
+
+  required_version = ">= 1.5"
+}
+variable "region" {
+  description = "The region to deploy to."
+  type        = string
+}
+
+
+# The bucket of the logs.
+resource "aws_s3_bucket" "logs" {
+  count  = var.enabled ? 1 : 0
+  bucket = "logs"
+
+  tags = {
+    Name = "logs"
+
+  }
+  depends_on = [aws_iam_role.logs]
+}
+# eos-ignore: spacing.between_blocks -- kept with the bucket
+resource "aws_s3_bucket_policy" "logs" {
+  for_each = var.policies
+
+  bucket = aws_s3_bucket.logs[0].id
+  policy = each.value
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+locals {
+  script = <<-EOT
+    #!/bin/sh
+
+
+    echo "blank lines in a heredoc are content"
+  EOT
+}
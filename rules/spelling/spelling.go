@@ -0,0 +1,227 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package spelling
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/dictionary"
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// UnknownWordMessage is emitted for a word that is neither in the dictionary
+// nor known to the module or project.
+const UnknownWordMessage = "Check the spelling of %q."
+
+// defaultWordList is the project word list read when word_list isn't set. It
+// needn't exist.
+const defaultWordList = ".eos-words"
+
+// spellingConfig represents the configuration for the SpellingRule.
+type spellingConfig struct {
+	Enabled *bool  `hclext:"enabled,optional" hcl:"enabled,optional"`
+	Level   string `hclext:"level,optional" hcl:"level,optional"`
+	// WordList is the path of a file of project words, one per line.
+	WordList string `hclext:"word_list,optional" hcl:"word_list,optional"`
+	// Words are more project words.
+	Words []string `hclext:"words,optional" hcl:"words,optional"`
+}
+
+// defaultSpellingConfig is the default configuration for the SpellingRule.
+var defaultSpellingConfig = spellingConfig{
+	Enabled: rulehelper.BoolPtr(true),
+	Level:   "warning",
+}
+
+// Rule checks the spelling of descriptions and comments.
+type Rule struct {
+	tflint.DefaultRule
+	Config spellingConfig
+	// RuleName is the rule block name to load from the config file. If empty,
+	// defaults to "eos_spelling".
+	RuleName string
+	// overrides are the override blocks of the rule block, applied by ForFile.
+	overrides rulehelper.Overrides[spellingConfig]
+	// identifiers are the words of the names in the module, lowercased.
+	identifiers map[string]bool
+	// projectWords are the words of the word list and config, lowercased.
+	projectWords map[string]bool
+}
+
+// Check checks whether the rule conditions are met.
+func (r *Rule) Check(runner tflint.Runner) error {
+	ignores, overrides, err := rulehelper.BeginCheck(runner, r, &r.Config)
+	if err != nil || ignores == nil {
+		return err
+	}
+	r.overrides = overrides
+
+	// Word lists are read once per path, as overrides often share one.
+	lists := map[string][]string{}
+	if err := r.loadWords(lists); err != nil {
+		return fmt.Errorf("rule %q: %w", r.Name(), err)
+	}
+
+	files, err := ignores.GetFiles()
+	if err != nil {
+		return err
+	}
+	r.identifiers = findIdentifiers(files)
+
+	for filename, file := range files {
+		// Descriptions are found by walking the native syntax tree, and JSON
+		// has no comments, so only native files are checked.
+		body, ok := file.Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		fileRule, enabled := rulehelper.ForFile(r, filename)
+		if !enabled {
+			continue
+		}
+		if err := fileRule.loadWords(lists); err != nil {
+			return fmt.Errorf("rule %q: %w", r.Name(), err)
+		}
+
+		source := rulehelper.Source(filename, file)
+		for _, w := range fileRule.findWords(source, body) {
+			fileRule.checkWord(ignores, w)
+		}
+	}
+
+	return ignores.ReportIgnores()
+}
+
+// loadWords sets the project words of the rule from its config, reading the
+// word list through the lists cache.
+func (r *Rule) loadWords(lists map[string][]string) error {
+	path := r.Config.WordList
+	if path == "" {
+		path = defaultWordList
+	}
+
+	list, ok := lists[path]
+	if !ok {
+		var err error
+		list, err = readWordList(path)
+		if errors.Is(err, fs.ErrNotExist) && r.Config.WordList == "" {
+			err = nil
+		}
+		if err != nil {
+			return fmt.Errorf("\"word_list\": %w", err)
+		}
+		lists[path] = list
+	}
+
+	r.projectWords = map[string]bool{}
+	for _, word := range list {
+		r.projectWords[strings.ToLower(word)] = true
+	}
+	for _, word := range r.Config.Words {
+		r.projectWords[strings.ToLower(word)] = true
+	}
+	return nil
+}
+
+// readWordList returns the words of a word list file: one word per line, with
+// blank lines and lines starting with # skipped.
+func readWordList(path string) ([]string, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var words []string
+	scanner := bufio.NewScanner(bytes.NewReader(src))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" && !strings.HasPrefix(line, "#") {
+			words = append(words, line)
+		}
+	}
+	return words, scanner.Err()
+}
+
+// checkWord reports a word that is neither in the dictionary nor a project
+// word or identifier.
+func (r *Rule) checkWord(runner tflint.Runner, w word) {
+	if r.isKnown(w.text) {
+		return
+	}
+
+	message := fmt.Sprintf(UnknownWordMessage, w.text)
+	if err := runner.EmitIssue(r, message, w.rng); err != nil {
+		logger.Error(err.Error())
+	}
+	logger.Debug(message)
+}
+
+// isKnown reports whether text is a word of the dictionary, the project or
+// the module. A possessive is known if the word it is formed from is.
+func (r *Rule) isKnown(text string) bool {
+	if dictionary.Default().Check(text) {
+		return true
+	}
+
+	lower := strings.ToLower(strings.ReplaceAll(text, "’", "'"))
+	for _, candidate := range []string{lower, strings.TrimSuffix(lower, "'s")} {
+		if r.projectWords[candidate] || r.identifiers[candidate] || dictionary.Default().Check(candidate) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewSpellingRule returns a new rule.
+func NewSpellingRule() *Rule {
+	rule := &Rule{}
+	rule.Config = defaultSpellingConfig
+	return rule
+}
+
+// ForFile returns the rule with the config that applies to filename.
+func (r *Rule) ForFile(filename string) *Rule {
+	config, ok := r.overrides.Match(filename)
+	if !ok {
+		return r
+	}
+	fileRule := *r
+	fileRule.Config = config
+	return &fileRule
+}
+
+// Enabled returns whether the rule is enabled by default.
+func (r *Rule) Enabled() bool {
+	return r.Config.Enabled == nil || *r.Config.Enabled
+}
+
+// Link returns the rule reference link.
+func (r *Rule) Link() string {
+	return "https://github.com/tfctl/tflint-ruleset-elements-of-style/blob/main/docs/rules/eos_spelling.md"
+}
+
+// Name returns the rule name.
+func (r *Rule) Name() string {
+	if r.RuleName != "" {
+		return r.RuleName
+	}
+
+	return "eos_spelling"
+}
+
+// Severity returns the rule severity.
+func (r *Rule) Severity() tflint.Severity {
+	return rulehelper.ToSeverity(r.Config.Level)
+}
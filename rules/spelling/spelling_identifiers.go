@@ -0,0 +1,58 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package spelling
+
+import (
+	"strings"
+
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+// findIdentifiers returns the words of the names in the module: block types,
+// labels and argument names. Resource types, variable names, provider names
+// and required_providers entries are all among them. Names are split on
+// underscores and hyphens, so a comment may mention the s3 in aws_s3_bucket
+// or the vpc in var.vpc_id.
+func findIdentifiers(files map[string]*hcl.File) map[string]bool {
+	identifiers := map[string]bool{}
+	add := func(name string) {
+		for _, part := range strings.FieldsFunc(name, func(r rune) bool { return r == '_' || r == '-' }) {
+			identifiers[strings.ToLower(part)] = true
+		}
+	}
+
+	var walk func(*hclsyntax.Body)
+	walk = func(body *hclsyntax.Body) {
+		for name := range body.Attributes {
+			add(name)
+		}
+		for _, block := range body.Blocks {
+			add(block.Type)
+			for _, label := range block.Labels {
+				add(label)
+			}
+			walk(block.Body)
+		}
+	}
+
+	for filename, file := range files {
+		if body, ok := file.Body.(*hclsyntax.Body); ok {
+			walk(body)
+			continue
+		}
+		// JSON bodies can't be walked without a schema, so only the names
+		// of their top-level blocks are known.
+		for _, block := range rulehelper.Source(filename, file).Blocks() {
+			add(block.Type)
+			for _, label := range block.Labels {
+				add(label)
+			}
+		}
+	}
+
+	return identifiers
+}
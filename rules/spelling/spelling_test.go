@@ -0,0 +1,94 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package spelling
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/testhelper"
+)
+
+func TestSpelling(t *testing.T) {
+	if !flag.Parsed() {
+		flag.Parse()
+	}
+
+	t.Run("Config", testSpellingConfig)
+	t.Run("ConfigErrors", testSpellingConfigErrors)
+
+	t.Run("Rule", testSpellingRule)
+}
+
+func testSpellingConfig(t *testing.T) {
+	cases := []testhelper.ConfigTestCase{
+		{
+			Name: "eos_spelling",
+			Want: defaultSpellingConfig,
+		},
+		{
+			Name: "eos_spelling_words",
+			Want: func() spellingConfig {
+				cfg := defaultSpellingConfig
+				cfg.Level = "notice"
+				cfg.WordList = "testdata/project.words"
+				cfg.Words = []string{"Tfctl"}
+				return cfg
+			}(),
+		},
+	}
+
+	testhelper.ConfigTestRunner(t, defaultSpellingConfig, cases)
+}
+
+func testSpellingConfigErrors(t *testing.T) {
+	cases := []testhelper.ConfigErrorTestCase{
+		{
+			Name: "eos_spelling_missing_list",
+			Want: `rule "eos_spelling_missing_list": "word_list": open testdata/missing.words: no such file or directory`,
+		},
+		{
+			Name: "eos_spelling_typo",
+			Want: `rule "eos_spelling_typo": unknown option "word" at testdata/.tflint_test.hcl:22,3-7. Did you mean "words"? Valid options: enabled, level, word_list, words, override { files, ... }.`,
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewSpellingRule() }
+	testhelper.ConfigErrorTestRunner(t, ruleFactory, cases)
+}
+
+func testSpellingRule(t *testing.T) {
+	content, _ := os.ReadFile("./testdata/spelling_test.tf")
+
+	misspelled := []string{
+		fmt.Sprintf(UnknownWordMessage, "recieves"),
+		fmt.Sprintf(UnknownWordMessage, "aggregaton"),
+		fmt.Sprintf(UnknownWordMessage, "availble"),
+	}
+
+	cases := []testhelper.RuleTestCase{
+		{
+			Name:    "eos_spelling",
+			Content: string(content),
+			Want: append(append([]string{}, misspelled...),
+				fmt.Sprintf(UnknownWordMessage, "kubectl"),
+				fmt.Sprintf(UnknownWordMessage, "Tfctl"),
+			),
+		},
+		{
+			Name:    "eos_spelling_words",
+			Content: string(content),
+			Want: append(append([]string{}, misspelled...),
+				// The annotation names spelling, not this rule.
+				fmt.Sprintf(UnknownWordMessage, "Acmecorp"),
+			),
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewSpellingRule() }
+	testhelper.RuleTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "spelling_test.tf")
+}
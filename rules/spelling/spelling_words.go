@@ -0,0 +1,221 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package spelling
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+// word is a word to check, along with where it was written.
+type word struct {
+	text string
+	rng  hcl.Range
+}
+
+// located is a rune of the source and where it starts.
+type located struct {
+	r   rune
+	pos hcl.Pos
+}
+
+// findWords collects the words of the comments and descriptions of a file.
+// Annotations, code and identifiers are left out.
+func (r *Rule) findWords(source *rulehelper.SourceFile, body *hclsyntax.Body) []word {
+	var words []word
+	var walk func(*hclsyntax.Body)
+	walk = func(b *hclsyntax.Body) {
+		if attr, ok := b.Attributes["description"]; ok {
+			words = append(words, stringWords(source.File().Bytes, attr.Expr)...)
+		}
+		for _, block := range b.Blocks {
+			walk(block.Body)
+		}
+	}
+	walk(body)
+
+	tokens, _ := source.Tokens()
+	for _, i := range source.Comments() {
+		token := tokens[i]
+		if rulehelper.IsAnnotation(string(token.Bytes)) {
+			continue
+		}
+		words = append(words, scanWords(token.Bytes, token.Range.Start, token.Range.Filename)...)
+	}
+
+	return words
+}
+
+// stringWords returns the words of the literal parts of a string. The source
+// is scanned rather than the values of the parts, so that each word is
+// reported where it was written.
+func stringWords(src []byte, expr hclsyntax.Expression) []word {
+	template, ok := expr.(*hclsyntax.TemplateExpr)
+	if !ok {
+		return nil
+	}
+
+	var words []word
+	for _, part := range template.Parts {
+		if _, ok := part.(*hclsyntax.LiteralValueExpr); !ok {
+			continue
+		}
+		rng := part.Range()
+		if rng.End.Byte > len(src) || rng.Start.Byte > rng.End.Byte {
+			continue
+		}
+		words = append(words, scanWords(src[rng.Start.Byte:rng.End.Byte], rng.Start, rng.Filename)...)
+	}
+	return words
+}
+
+// scanWords returns the words of src, which starts at start. It splits src
+// into fields on spaces and skips those that look like code: URLs, paths,
+// e-mail addresses, assignments, dotted names and identifiers with
+// underscores or digits. Text in backticks and escape sequences are skipped
+// too. The words of the other fields are checked unless they are too short,
+// in capitals, or in camel case.
+func scanWords(src []byte, start hcl.Pos, filename string) []word {
+	runes := locate(src, start)
+	// The last rune is the sentinel.
+	n := len(runes) - 1
+
+	var words []word
+	code := false
+	for i := 0; i < n; {
+		switch r := runes[i].r; {
+		case r == '`':
+			code = !code
+			i++
+			continue
+		case code || unicode.IsSpace(r):
+			i++
+			continue
+		case r == '\\':
+			// An escape sequence, e.g. \n, separates the words around it.
+			i += 2
+			continue
+		}
+
+		j := i
+		for j < n && !unicode.IsSpace(runes[j].r) && runes[j].r != '`' && runes[j].r != '\\' {
+			j++
+		}
+		if !isCode(runes[i:j]) {
+			words = append(words, fieldWords(runes[i:j], runes, j, filename)...)
+		}
+		i = j
+	}
+	return words
+}
+
+// locate returns the runes of src and where each starts.
+func locate(src []byte, start hcl.Pos) []located {
+	var runes []located
+	pos := start
+	for len(src) > 0 {
+		r, size := utf8.DecodeRune(src)
+		runes = append(runes, located{r: r, pos: pos})
+		pos.Byte += size
+		pos.Column++
+		if r == '\n' {
+			pos.Line++
+			pos.Column = 1
+		}
+		src = src[size:]
+	}
+	// A sentinel holds the position past the end.
+	return append(runes, located{pos: pos})
+}
+
+// isCode reports whether a field looks like code rather than prose.
+func isCode(field []located) bool {
+	var text strings.Builder
+	for _, l := range field {
+		text.WriteRune(l.r)
+	}
+	s := text.String()
+
+	if strings.ContainsAny(s, "@/=_${}<>[]|*") {
+		return true
+	}
+	for i, r := range s {
+		if unicode.IsDigit(r) {
+			return true
+		}
+		// A period between letters makes a dotted name, e.g. var.region.
+		if r == '.' && i > 0 && i+1 < len(s) && unicode.IsLetter(rune(s[i-1])) && unicode.IsLetter(rune(s[i+1])) {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldWords returns the words of a field. A word is a run of letters,
+// possibly with apostrophes inside, as in "module's". Hyphens and other
+// punctuation separate words. end is the index of the rune after the field
+// in runes, which holds its position.
+func fieldWords(field []located, runes []located, end int, filename string) []word {
+	var words []word
+	for i := 0; i < len(field); {
+		if !unicode.IsLetter(field[i].r) {
+			i++
+			continue
+		}
+
+		j := i
+		for j < len(field) && (unicode.IsLetter(field[j].r) || isApostrophe(field[j].r)) {
+			j++
+		}
+		// Apostrophes may close a quote rather than belong to the word.
+		k := j
+		for k > i && isApostrophe(field[k-1].r) {
+			k--
+		}
+
+		endPos := runes[end].pos
+		if k < len(field) {
+			endPos = field[k].pos
+		}
+
+		var text strings.Builder
+		for _, l := range field[i:k] {
+			text.WriteRune(l.r)
+		}
+		if isProse(text.String()) {
+			words = append(words, word{
+				text: text.String(),
+				rng:  hcl.Range{Filename: filename, Start: field[i].pos, End: endPos},
+			})
+		}
+		i = j
+	}
+	return words
+}
+
+// isApostrophe reports whether r is a straight or curly apostrophe.
+func isApostrophe(r rune) bool {
+	return r == '\'' || r == '’'
+}
+
+// isProse reports whether a word is worth checking: at least three letters
+// long, not an acronym in capitals, and not in camel case.
+func isProse(text string) bool {
+	runes := []rune(text)
+	if len(runes) < 3 {
+		return false
+	}
+	for _, r := range runes[1:] {
+		if unicode.IsUpper(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package spelling
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/hcl/v2"
+)
+
+func TestScanWords(t *testing.T) {
+	cases := []struct {
+		name string
+		src  string
+		want []string
+	}{
+		{"prose", "The module's read-only bucket.", []string{"The", "module's", "read", "only", "bucket"}},
+		{"short", "An S3 id of a VM.", nil},
+		{"capitals", "Returns the ARN as JSON.", []string{"Returns", "the"}},
+		{"camel case", "Set maxSize first.", []string{"Set", "first"}},
+		{"quotes", "Say 'hello' twice.", []string{"Say", "hello", "twice"}},
+		{"code", "See https://example.com, var.region, a_b, v2 and x=y.", []string{"See", "and"}},
+		{"backticks", "Run `terraform fmt -recursive` first.", []string{"Run", "first"}},
+		{"escapes", `One\ntwo`, []string{"One", "two"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []string
+			for _, w := range scanWords([]byte(tc.src), hcl.InitialPos, "test.tf") {
+				got = append(got, w.text)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("scanWords(%q) mismatch (-want +got):\n%s", tc.src, diff)
+			}
+		})
+	}
+}
+
+func TestScanWordsRange(t *testing.T) {
+	words := scanWords([]byte("# One\n# two"), hcl.Pos{Line: 3, Column: 5, Byte: 40}, "test.tf")
+	if len(words) != 2 {
+		t.Fatalf("got %d words, want 2", len(words))
+	}
+
+	want := hcl.Range{Filename: "test.tf", Start: hcl.Pos{Line: 4, Column: 3, Byte: 48}, End: hcl.Pos{Line: 4, Column: 6, Byte: 51}}
+	if diff := cmp.Diff(want, words[1].rng); diff != "" {
+		t.Errorf("range mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,23 @@
+# Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+# SPDX-License-Identifier: Apache-2.0
+
+rule "eos_spelling" {
+  enabled = true
+}
+
+rule "eos_spelling_words" {
+  enabled   = true
+  level     = "notice"
+  word_list = "testdata/project.words"
+  words     = ["Tfctl"]
+}
+
+rule "eos_spelling_missing_list" {
+  enabled   = true
+  word_list = "testdata/missing.words"
+}
+
+rule "eos_spelling_typo" {
+  enabled = true
+  word    = ["tfctl"]
+}
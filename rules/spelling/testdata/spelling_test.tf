@@ -0,0 +1,41 @@
+# Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+# SPDX-License-Identifier: Apache-2.0
+
+terraform {
+  required_providers {
+    cloudflare = {
+      source = "cloudflare/cloudflare"
+    }
+  }
+}
+
+# The bucket that recieves the logs. See https://example.com/docs/logz for
+# details, or mail ops@example.com. Set `enable_logz` to turn it on.
+resource "aws_s3_bucket" "logs" {
+  bucket = "logs"
+}
+
+variable "retention_days" {
+  description = "Number of days the bucket keeps logs before it expires them."
+  type        = number
+}
+
+variable "zone" {
+  description = "The cloudflare zone of the bucket, e.g. example.com.\nManaged by kubectl, not by Tfctl."
+  type        = string
+}
+
+output "bucket" {
+  description = "The S3 bucket's name, from var.retention_days and ${var.zone}aggregaton."
+  value       = aws_s3_bucket.logs.bucket
+}
+
+// The JSON and ARN of the bucket are availble in the bucket's attributes,
+// as is its kubernetes-style logzName.
+/* tflint-ignore: eos_spelling */
+
+# eos-ignore: spelling -- the name of the vendor
+# Powered by Acmecorp.
+locals {
+  name = "logs"
+}
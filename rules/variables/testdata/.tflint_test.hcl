@@ -0,0 +1,28 @@
+# Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+# SPDX-License-Identifier: Apache-2.0
+
+rule "eos_variables" {
+  enabled = true
+}
+
+rule "eos_variables_relaxed" {
+  enabled     = true
+  description = false
+  type        = false
+}
+
+rule "eos_variables_levels" {
+  enabled  = true
+  type_any = "error"
+  order    = "notice"
+}
+
+rule "eos_variables_bad_level" {
+  enabled      = true
+  null_default = "loud"
+}
+
+rule "eos_variables_typo" {
+  enabled = true
+  nul_default = false
+}
@@ -0,0 +1,108 @@
+# Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+# SPDX-License-Identifier: Apache-2.0
+
+# #########
+# Tests that will emit issues.
+
+variable "no_description" {
+  type = string
+}
+
+variable "no_type" {
+  description = "The region to deploy to."
+}
+
+variable "type_any" {
+  description = "Anything at all."
+  type        = any
+}
+
+variable "null_default" {
+  description = "The optional key pair."
+  type        = string
+  default     = null
+}
+
+variable "not_nullable" {
+  description = "The optional key pair."
+  type        = string
+  default     = null
+  nullable    = false
+}
+
+variable "error_message" {
+  description = "The instance size."
+  type        = string
+
+  validation {
+    condition     = length(var.error_message) > 0
+    error_message = "Size must not be empty"
+  }
+
+  validation {
+    condition     = var.error_message != "nano"
+    error_message = "Size ${var.error_message} is too small"
+  }
+}
+
+variable "order" {
+  type        = string
+  description = "Type comes before description."
+}
+
+variable "validation_first" {
+  validation {
+    condition     = var.validation_first > 0
+    error_message = "Must be positive."
+  }
+
+  description = "Validation comes last."
+  type        = number
+}
+
+# #########
+# Tests that will not emit issues.
+
+variable "complete" {
+  description = "The number of instances."
+  type        = number
+  default     = 1
+  sensitive   = false
+  nullable    = false
+
+  validation {
+    condition     = var.complete > 0
+    error_message = "Count must be positive."
+  }
+
+  validation {
+    condition     = var.complete < 10
+    error_message = <<-EOT
+      Count must be below ten, not ${var.complete}.
+    EOT
+  }
+}
+
+variable "nullable" {
+  description = "The optional key pair."
+  type        = string
+  default     = null
+  nullable    = true
+}
+
+variable "interpolated" {
+  description = "The name prefix."
+  type        = string
+  ephemeral   = false
+
+  validation {
+    condition     = length(var.interpolated) < 8
+    error_message = "Prefix is too long: ${var.interpolated}"
+  }
+}
+
+# eos-ignore: variables.type_any -- passed through to the module as is
+variable "ignored_any" {
+  description = "Anything at all."
+  type        = any
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package variables
+
+import (
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// variablesConfig represents the configuration for the VariablesRule.
+type variablesConfig struct {
+	Description  rulehelper.SubCheck `hclext:"description,optional" hcl:"description,optional"`
+	Enabled      *bool               `hclext:"enabled,optional" hcl:"enabled,optional"`
+	ErrorMessage rulehelper.SubCheck `hclext:"error_message,optional" hcl:"error_message,optional"`
+	Level        string              `hclext:"level,optional" hcl:"level,optional"`
+	NullDefault  rulehelper.SubCheck `hclext:"null_default,optional" hcl:"null_default,optional"`
+	Order        rulehelper.SubCheck `hclext:"order,optional" hcl:"order,optional"`
+	Type         rulehelper.SubCheck `hclext:"type,optional" hcl:"type,optional"`
+	TypeAny      rulehelper.SubCheck `hclext:"type_any,optional" hcl:"type_any,optional"`
+}
+
+// defaultVariablesConfig is the default configuration for the VariablesRule.
+var defaultVariablesConfig = variablesConfig{
+	Enabled: rulehelper.BoolPtr(true),
+	Level:   "warning",
+}
+
+// variableBlocks are the blocks the rule walks.
+var variableBlocks = []rulehelper.BlockDef{
+	{Typ: "variable", Labels: []string{"name"}},
+}
+
+// variableSchema holds the arguments of a variable block the checks look at.
+var variableSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{
+		{Name: "description"},
+		{Name: "type"},
+		{Name: "default"},
+		{Name: "sensitive"},
+		{Name: "nullable"},
+	},
+	Blocks: []hcl.BlockHeaderSchema{
+		{Type: "validation"},
+	},
+}
+
+// Rule checks the declarations of variables.
+type Rule struct {
+	tflint.DefaultRule
+	Config variablesConfig
+	// RuleName is the rule block name to load from the config file. If empty,
+	// defaults to "eos_variables".
+	RuleName string
+	// overrides are the override blocks of the rule block, applied by ForFile.
+	overrides rulehelper.Overrides[variablesConfig]
+}
+
+// Check checks whether the rule conditions are met.
+func (r *Rule) Check(runner tflint.Runner) error {
+	ignores, overrides, err := rulehelper.BeginCheck(runner, r, &r.Config)
+	if err != nil || ignores == nil {
+		return err
+	}
+	r.overrides = overrides
+
+	// Each check decides for itself whether it is switched on, as overrides
+	// can switch it on or off for some files.
+	if err := rulehelper.WalkBlockContents(ignores, variableBlocks, r,
+		checkDescription,
+		checkType,
+		checkNullDefault,
+		checkErrorMessage,
+		checkOrder); err != nil {
+		return err
+	}
+
+	return ignores.ReportIgnores()
+}
+
+// variableArguments returns the arguments of a variable block the checks look
+// at.
+func variableArguments(block *hcl.Block) *hcl.BodyContent {
+	content, _, _ := block.Body.PartialContent(variableSchema)
+	return content
+}
+
+// NewVariablesRule returns a new rule.
+func NewVariablesRule() *Rule {
+	rule := &Rule{}
+	rule.Config = defaultVariablesConfig
+	return rule
+}
+
+// ForFile returns the rule with the config that applies to filename.
+func (r *Rule) ForFile(filename string) *Rule {
+	config, ok := r.overrides.Match(filename)
+	if !ok {
+		return r
+	}
+	fileRule := *r
+	fileRule.Config = config
+	return &fileRule
+}
+
+// Enabled returns whether the rule is enabled by default.
+func (r *Rule) Enabled() bool {
+	return r.Config.Enabled == nil || *r.Config.Enabled
+}
+
+// Link returns the rule reference link.
+func (r *Rule) Link() string {
+	return "https://github.com/tfctl/tflint-ruleset-elements-of-style/blob/main/docs/rules/eos_variables.md"
+}
+
+// Name returns the rule name.
+func (r *Rule) Name() string {
+	if r.RuleName != "" {
+		return r.RuleName
+	}
+
+	return "eos_variables"
+}
+
+// Severity returns the rule severity.
+func (r *Rule) Severity() tflint.Severity {
+	return rulehelper.ToSeverity(r.Config.Level)
+}
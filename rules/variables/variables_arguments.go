@@ -0,0 +1,85 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package variables
+
+import (
+	"fmt"
+
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Messages emitted by the argument checks. Each takes the variable name.
+const (
+	MissingDescriptionMessage = "Variable '%s' should have a description."
+	MissingTypeMessage        = "Variable '%s' should have a type."
+	TypeAnyMessage            = "Variable '%s' should have a more specific type than any."
+	NullDefaultMessage        = "Variable '%s' defaults to null and should set nullable = true."
+)
+
+// checkDescription checks that the variable has a description.
+func checkDescription(runner tflint.Runner, r *Rule, block *hcl.Block) {
+	if !r.Config.Description.Enabled() {
+		return
+	}
+
+	if _, exists := variableArguments(block).Attributes["description"]; !exists {
+		message := fmt.Sprintf(MissingDescriptionMessage, block.Labels[0])
+		rulehelper.EmitIssue(runner, r, "description", r.Config.Description.Level(), message, rulehelper.DefRange(block))
+	}
+}
+
+// checkType checks that the variable has a type, and that the type says more
+// than any.
+func checkType(runner tflint.Runner, r *Rule, block *hcl.Block) {
+	attr, exists := variableArguments(block).Attributes["type"]
+	switch {
+	case !exists:
+		if r.Config.Type.Enabled() {
+			message := fmt.Sprintf(MissingTypeMessage, block.Labels[0])
+			rulehelper.EmitIssue(runner, r, "type", r.Config.Type.Level(), message, rulehelper.DefRange(block))
+		}
+	case hcl.ExprAsKeyword(attr.Expr) == "any":
+		if r.Config.TypeAny.Enabled() {
+			message := fmt.Sprintf(TypeAnyMessage, block.Labels[0])
+			rulehelper.EmitIssue(runner, r, "type_any", r.Config.TypeAny.Level(), message, attr.Range)
+		}
+	}
+}
+
+// checkNullDefault checks that a variable defaulting to null says it is
+// nullable, so that null reads as intended rather than as a placeholder.
+func checkNullDefault(runner tflint.Runner, r *Rule, block *hcl.Block) {
+	if !r.Config.NullDefault.Enabled() {
+		return
+	}
+
+	args := variableArguments(block)
+	attr, exists := args.Attributes["default"]
+	if !exists || !isStatic(attr.Expr, cty.NullVal(cty.DynamicPseudoType)) {
+		return
+	}
+	if nullable, exists := args.Attributes["nullable"]; exists && isStatic(nullable.Expr, cty.True) {
+		return
+	}
+
+	message := fmt.Sprintf(NullDefaultMessage, block.Labels[0])
+	rulehelper.EmitIssue(runner, r, "null_default", r.Config.NullDefault.Level(), message, attr.Range)
+}
+
+// isStatic reports whether expr evaluates to want without a context. Null
+// matches a null of any type.
+func isStatic(expr hcl.Expression, want cty.Value) bool {
+	val, diags := expr.Value(nil)
+	if diags.HasErrors() || !val.IsKnown() {
+		return false
+	}
+	if want.IsNull() || val.IsNull() {
+		return want.IsNull() && val.IsNull()
+	}
+	return val.RawEquals(want)
+}
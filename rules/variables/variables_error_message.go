@@ -0,0 +1,76 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package variables
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// ErrorMessagePeriodMessage is the message emitted when the error_message of
+// a validation block doesn't end with a period. It takes the variable name.
+const ErrorMessagePeriodMessage = "Validation error_message of variable '%s' should end with a period."
+
+// validationSchema holds the arguments of a validation block.
+var validationSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{
+		{Name: "condition"},
+		{Name: "error_message"},
+	},
+}
+
+// checkErrorMessage checks that the error_message of each validation block of
+// the variable ends with a period, as Terraform prints it as a sentence.
+func checkErrorMessage(runner tflint.Runner, r *Rule, block *hcl.Block) {
+	if !r.Config.ErrorMessage.Enabled() {
+		return
+	}
+
+	for _, validation := range variableArguments(block).Blocks.OfType("validation") {
+		content, _, _ := validation.Body.PartialContent(validationSchema)
+		attr, exists := content.Attributes["error_message"]
+		if !exists {
+			continue
+		}
+
+		text, ok := messageEnd(attr.Expr)
+		if !ok || strings.HasSuffix(strings.TrimSpace(text), ".") {
+			continue
+		}
+
+		message := fmt.Sprintf(ErrorMessagePeriodMessage, block.Labels[0])
+		rulehelper.EmitIssue(runner, r, "error_message", r.Config.ErrorMessage.Level(), message, attr.Range)
+	}
+}
+
+// messageEnd returns the literal text the message expr ends with. It returns
+// false if the message ends in an interpolation or isn't a string at all, as
+// its end is then only known once Terraform evaluates it.
+func messageEnd(expr hcl.Expression) (string, bool) {
+	native, ok := rulehelper.NativeExpression(expr)
+	if !ok {
+		return "", false
+	}
+
+	var last hclsyntax.Expression = native
+	if template, ok := native.(*hclsyntax.TemplateExpr); ok {
+		if len(template.Parts) == 0 {
+			return "", true
+		}
+		last = template.Parts[len(template.Parts)-1]
+	}
+
+	literal, ok := last.(*hclsyntax.LiteralValueExpr)
+	if !ok || literal.Val.Type() != cty.String || literal.Val.IsNull() {
+		return "", false
+	}
+	return literal.Val.AsString(), true
+}
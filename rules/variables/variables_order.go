@@ -0,0 +1,77 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package variables
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/rulehelper"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// MisOrderedMessage is the message emitted when the arguments of a variable
+// are out of order. It takes the variable name, the misplaced argument and the
+// one it should come before.
+const MisOrderedMessage = "Variable '%s' should have %s before %s."
+
+// argumentOrder is the order arguments of a variable appear in. Arguments not
+// listed may appear anywhere.
+var argumentOrder = []string{"description", "type", "default", "sensitive", "nullable", "validation"}
+
+// orderItem is an ordered argument or validation block of a variable.
+type orderItem struct {
+	name string
+	rank int
+	rng  hcl.Range
+}
+
+// checkOrder checks that the arguments and validation blocks of the variable
+// follow argumentOrder. Only the first misplaced one is reported, as moving it
+// often settles the rest.
+func checkOrder(runner tflint.Runner, r *Rule, block *hcl.Block) {
+	if !r.Config.Order.Enabled() {
+		return
+	}
+
+	// Argument order is lost when JSON is decoded, so it is only checked in
+	// native files.
+	body, ok := block.Body.(*hclsyntax.Body)
+	if !ok {
+		return
+	}
+
+	ranks := make(map[string]int, len(argumentOrder))
+	for i, name := range argumentOrder {
+		ranks[name] = i
+	}
+
+	var items []orderItem
+	for name, attr := range body.Attributes {
+		if rank, ok := ranks[name]; ok {
+			items = append(items, orderItem{name: name, rank: rank, rng: attr.SrcRange})
+		}
+	}
+	for _, nested := range body.Blocks {
+		if rank, ok := ranks[nested.Type]; ok {
+			items = append(items, orderItem{name: nested.Type, rank: rank, rng: nested.Range()})
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].rng.Start.Byte < items[j].rng.Start.Byte
+	})
+
+	for i, item := range items {
+		for _, before := range items[:i] {
+			if item.rank < before.rank {
+				message := fmt.Sprintf(MisOrderedMessage, block.Labels[0], item.name, before.name)
+				rulehelper.EmitIssue(runner, r, "order", r.Config.Order.Level(), message, item.rng)
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright (c) 2025 Steve Taranto <staranto@gmail.com>.
+// SPDX-License-Identifier: Apache-2.0
+
+package variables
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/tfctl/tflint-ruleset-elements-of-style/internal/testhelper"
+)
+
+func TestVariables(t *testing.T) {
+	if !flag.Parsed() {
+		flag.Parse()
+	}
+
+	t.Run("Config", testVariablesConfig)
+	t.Run("ConfigErrors", testVariablesConfigErrors)
+
+	t.Run("JSON", testVariablesJSON)
+	t.Run("Levels", testVariablesLevels)
+	t.Run("Rule", testVariablesRule)
+}
+
+func testVariablesConfig(t *testing.T) {
+	cases := []testhelper.ConfigTestCase{
+		{
+			Name: "eos_variables",
+			Want: defaultVariablesConfig,
+		},
+		{
+			Name: "eos_variables_relaxed",
+			Want: func() variablesConfig {
+				cfg := defaultVariablesConfig
+				cfg.Description = "false"
+				cfg.Type = "false"
+				return cfg
+			}(),
+		},
+		{
+			Name: "eos_variables_levels",
+			Want: func() variablesConfig {
+				cfg := defaultVariablesConfig
+				cfg.TypeAny = "error"
+				cfg.Order = "notice"
+				return cfg
+			}(),
+		},
+	}
+
+	testhelper.ConfigTestRunner(t, defaultVariablesConfig, cases)
+}
+
+func testVariablesConfigErrors(t *testing.T) {
+	cases := []testhelper.ConfigErrorTestCase{
+		{
+			Name: "eos_variables_bad_level",
+			Want: `rule "eos_variables_bad_level": "null_default" must be true, false or a level (notice, warning, error), not "loud"`,
+		},
+		{
+			Name: "eos_variables_typo",
+			Want: `rule "eos_variables_typo": unknown option "nul_default" at testdata/.tflint_test.hcl:27,3-14. Did you mean "null_default"? Valid options: description, enabled, error_message, level, null_default, order, type, type_any, override { files, ... }.`,
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewVariablesRule() }
+	testhelper.ConfigErrorTestRunner(t, ruleFactory, cases)
+}
+
+func testVariablesRule(t *testing.T) {
+	content, _ := os.ReadFile("./testdata/variables_test.tf")
+
+	cases := []testhelper.RuleTestCase{
+		{
+			Name:    "eos_variables",
+			Content: string(content),
+			Want: []string{
+				fmt.Sprintf(MissingDescriptionMessage, "no_description"),
+				fmt.Sprintf(MissingTypeMessage, "no_type"),
+				fmt.Sprintf(TypeAnyMessage, "type_any"),
+				fmt.Sprintf(NullDefaultMessage, "null_default"),
+				fmt.Sprintf(NullDefaultMessage, "not_nullable"),
+				fmt.Sprintf(ErrorMessagePeriodMessage, "error_message"),
+				fmt.Sprintf(ErrorMessagePeriodMessage, "error_message"),
+				fmt.Sprintf(MisOrderedMessage, "order", "description", "type"),
+				fmt.Sprintf(MisOrderedMessage, "validation_first", "description", "validation"),
+			},
+		},
+		{
+			Name:    "eos_variables_relaxed",
+			Content: string(content),
+			Want: []string{
+				fmt.Sprintf(TypeAnyMessage, "type_any"),
+				fmt.Sprintf(NullDefaultMessage, "null_default"),
+				fmt.Sprintf(NullDefaultMessage, "not_nullable"),
+				fmt.Sprintf(ErrorMessagePeriodMessage, "error_message"),
+				fmt.Sprintf(ErrorMessagePeriodMessage, "error_message"),
+				fmt.Sprintf(MisOrderedMessage, "order", "description", "type"),
+				fmt.Sprintf(MisOrderedMessage, "validation_first", "description", "validation"),
+				// The annotation names variables.type_any, not this rule.
+				fmt.Sprintf(TypeAnyMessage, "ignored_any"),
+			},
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewVariablesRule() }
+	testhelper.RuleTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "variables_test.tf")
+}
+
+func testVariablesLevels(t *testing.T) {
+	cases := []testhelper.SeverityTestCase{
+		{
+			Name: "eos_variables_levels",
+			Content: `variable "region" {
+  type        = any
+  description = "The region to deploy to."
+}
+`,
+			Want: map[string]tflint.Severity{
+				fmt.Sprintf(TypeAnyMessage, "region"):                           tflint.ERROR,
+				fmt.Sprintf(MisOrderedMessage, "region", "description", "type"): tflint.NOTICE,
+			},
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewVariablesRule() }
+	testhelper.SeverityTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "variables_levels.tf")
+}
+
+func testVariablesJSON(t *testing.T) {
+	cases := []testhelper.RuleTestCase{
+		{
+			Name: "eos_variables",
+			Content: `{
+  "variable": {
+    "region": {
+      "type": "any"
+    },
+    "key_name": {
+      "description": "The optional key pair.",
+      "type": "string",
+      "default": null
+    },
+    "size": {
+      "description": "The instance size.",
+      "type": "string",
+      "validation": {
+        "condition": "${length(var.size) > 0}",
+        "error_message": "Size must not be empty"
+      }
+    },
+    "replicas": {
+      "default": 1,
+      "type": "number",
+      "description": "Order isn't checked in JSON.",
+      "validation": [
+        {
+          "condition": "${var.replicas > 0}",
+          "error_message": "Replicas must be positive."
+        }
+      ]
+    }
+  }
+}
+`,
+			Want: []string{
+				fmt.Sprintf(MissingDescriptionMessage, "region"),
+				fmt.Sprintf(TypeAnyMessage, "region"),
+				fmt.Sprintf(NullDefaultMessage, "key_name"),
+				fmt.Sprintf(ErrorMessagePeriodMessage, "size"),
+			},
+		},
+	}
+
+	ruleFactory := func() tflint.Rule { return NewVariablesRule() }
+	testhelper.RuleTestRunner(t, ruleFactory, "testdata/.tflint_test.hcl", cases, "variables_test.tf.json")
+}